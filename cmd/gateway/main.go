@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"kafka-gateway/internal/handler"
 	"kafka-gateway/internal/kafka"
 	"kafka-gateway/internal/middleware"
+	"kafka-gateway/internal/tenant"
 	pb "kafka-gateway/proto/gen"
 
 	"github.com/gin-gonic/gin"
@@ -60,18 +62,23 @@ func main() {
 		logger.Fatal("Failed to load configuration", zap.Error(err))
 	}
 
-	// Initialize Kafka client
-	var kafkaClient *kafka.Client
-	if client, err := kafka.NewClient(cfg.Kafka); err != nil {
-		logger.Warn("Failed to create Kafka client, API endpoints will not be functional", zap.Error(err))
-	} else {
-		kafkaClient = client
-		defer kafkaClient.Close()
+	// Initialize a Kafka client per cluster; each connects in the background
+	// and requests are rejected as unavailable until its cluster is reachable
+	clusters, err := kafka.NewRegistry(cfg, logger)
+	if err != nil {
+		logger.Fatal("Invalid Kafka configuration", zap.Error(err))
+	}
+	defer clusters.Close()
+	kafkaClient := clusters.Default()
+
+	tenants, err := tenant.NewRegistry(cfg.Tenants, cfg.Auth)
+	if err != nil {
+		logger.Fatal("Invalid tenant configuration", zap.Error(err))
 	}
 
 	// Start gRPC server
 	grpcAddr := ":9090" // gRPC server address
-	grpcServer := grpcserver.NewServer(kafkaClient, cfg)
+	grpcServer := grpcserver.NewServer(clusters, tenants, cfg)
 	go func() {
 		logger.Info("Starting gRPC server", zap.String("address", grpcAddr))
 		if err := grpcServer.Start(9090); err != nil {
@@ -81,7 +88,15 @@ func main() {
 
 	// Initialize gRPC-Gateway mux
 	ctx := context.Background()
-	gwmux := runtime.NewServeMux()
+	gwmux := runtime.NewServeMux(
+		// Pass the cluster selection header through as gRPC metadata
+		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+			if strings.EqualFold(key, handler.ClusterHeader) {
+				return grpcserver.ClusterMetadataKey, true
+			}
+			return runtime.DefaultHeaderMatcher(key)
+		}),
+	)
 
 	// Register gRPC-Gateway handlers with TLS
 	var opts []grpc.DialOption
@@ -126,34 +141,72 @@ func main() {
 	router.Use(middleware.Logger(logger))
 	router.Use(middleware.Metrics())
 	router.Use(middleware.CORS())
+	router.Use(middleware.ForwardHeaders(cfg.Server.ForwardHeaders))
 
 	// Add authentication middleware if enabled
 	if cfg.Auth.Enabled {
-		router.Use(middleware.Auth(cfg.Auth))
+		router.Use(middleware.Auth(cfg.Auth, tenants))
 	}
 
 	// Swagger documentation endpoint
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	// Health check endpoint
-	router.GET("/health", handler.HealthCheck)
+	// Health check endpoints
+	router.GET("/health", handler.Readiness(clusters))
+	router.GET("/health/live", handler.Liveness)
+	router.GET("/health/ready", handler.Readiness(clusters))
+	router.GET("/health/startup", handler.Startup(kafkaClient))
 
 	// Metrics endpoint
 	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
 
-	// Legacy REST API endpoints (only if Kafka client is available)
-	if kafkaClient != nil {
-		api := router.Group("/api/v1")
-		{
-			api.POST("/publish/:topic", handler.PublishMessage(kafkaClient))
-			api.GET("/topics", handler.ListTopics(kafkaClient))
-			api.GET("/topics/:topic/partitions", handler.GetTopicPartitions(kafkaClient))
-			api.POST("/topics/:topic", handler.CreateTopic(kafkaClient))
-		}
+	// Legacy REST API endpoints, served by the cluster named in the
+	// X-Kafka-Cluster header or the default cluster, and again under
+	// /api/v1/clusters/:cluster for each named cluster. Tenants only see
+	// their own namespace and are kept off cluster-wide routes.
+	route := func(h func(*kafka.Client) gin.HandlerFunc) gin.HandlerFunc {
+		return handler.PerCluster(clusters, h)
+	}
+	tenancy := middleware.Tenant(tenants)
+	aclAdmin := handler.OperatorOnly("ACL management")
+	clusterAdmin := handler.OperatorOnly("cluster introspection")
+	router.GET("/api/v1/clusters", handler.ListClusters(clusters))
+	for _, api := range []*gin.RouterGroup{
+		router.Group("/api/v1", tenancy),
+		router.Group("/api/v1/clusters/:cluster", tenancy),
+	} {
+		api.POST("/publish/:topic", route(handler.PublishMessage))
+		api.POST("/publish/:topic/batch", route(handler.PublishBatch))
+		api.GET("/topics", route(handler.ListTopics))
+		api.GET("/topics/:topic/partitions", route(handler.GetTopicPartitions))
+		api.POST("/topics/:topic/partitions", route(handler.CreatePartitions))
+		api.GET("/topics/:topic/offsets", route(handler.GetOffsetsForTimestamp))
+		api.POST("/topics/:topic/truncate", route(handler.TruncateTopic))
+		api.GET("/topics/:topic/partitions/:partition/messages", route(handler.FetchMessages))
+		api.GET("/topics/:topic/stream", route(handler.StreamMessages))
+		api.GET("/ws", route(handler.WebSocket(cfg.Server.WebSocketOrigins)))
+		api.GET("/topics/:topic", route(handler.DescribeTopic))
+		api.POST("/topics/:topic", route(handler.CreateTopic))
+		api.DELETE("/topics/:topic", route(handler.DeleteTopic))
+		api.GET("/topics/:topic/config", route(handler.DescribeTopicConfig))
+		api.PATCH("/topics/:topic/config", route(handler.AlterTopicConfig))
+		api.GET("/consumer-groups", route(handler.ListConsumerGroups))
+		api.GET("/consumer-groups/:group", route(handler.DescribeConsumerGroup))
+		api.GET("/consumer-groups/:group/offsets", route(handler.GetConsumerGroupOffsets))
+		api.POST("/consumer-groups/:group/offsets/reset", route(handler.ResetConsumerGroupOffsets))
+		api.DELETE("/consumer-groups/:group", route(handler.DeleteConsumerGroup))
+		api.GET("/acls", aclAdmin, route(handler.ListACLs))
+		api.POST("/acls", aclAdmin, route(handler.CreateACLs))
+		api.DELETE("/acls", aclAdmin, route(handler.DeleteACLs))
+		api.GET("/cluster", clusterAdmin, route(handler.DescribeCluster))
+		api.GET("/cluster/log-dirs", clusterAdmin, route(handler.DescribeLogDirs))
 	}
 
-	// gRPC-Gateway endpoints
-	router.Any("/grpc/v1/*path", gin.WrapH(gwmux))
+	// gRPC-Gateway endpoints. Calls reach the gRPC server under the gateway's
+	// own certificate, which would lift tenant restrictions, so tenants must
+	// use /api/v1 or gRPC directly. The Authorization header is passed through,
+	// so operators identified by the auth secret are recognised there too.
+	router.Any("/grpc/v1/*path", tenancy, handler.OperatorOnly("the gRPC-Gateway"), gin.WrapH(gwmux))
 
 	// Create HTTPS server
 	srv := &http.Server{
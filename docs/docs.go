@@ -24,9 +24,116 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/v1/publish/{topic}": {
+        "/api/v1/acls": {
+            "get": {
+                "description": "List the ACLs matching every given filter. Omitted filters match any value; patternType=match selects the literal, wildcard and prefixed ACLs that apply to resourceName.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "acls"
+                ],
+                "summary": "List ACLs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "topic, group, cluster, transactionalid or delegationtoken",
+                        "name": "resourceType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Resource name",
+                        "name": "resourceName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "literal, prefixed or match",
+                        "name": "patternType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Principal, e.g. User:alice",
+                        "name": "principal",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Host",
+                        "name": "host",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Operation, e.g. read, write, describe or all",
+                        "name": "operation",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "allow or deny",
+                        "name": "permission",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ACLsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
             "post": {
-                "description": "Publish a message to a specified Kafka topic",
+                "description": "Create one or more ACLs. patternType defaults to literal, host to * and the resource name of cluster ACLs to kafka-cluster. Existing ACLs are left unchanged. Fails with 409 when the cluster has no authorizer.",
                 "consumes": [
                     "application/json"
                 ],
@@ -34,30 +141,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "kafka"
+                    "acls"
                 ],
-                "summary": "Publish message to Kafka topic",
+                "summary": "Create ACLs",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Topic name",
-                        "name": "topic",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Message to publish",
-                        "name": "message",
+                        "description": "ACLs to create",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.MessageRequest"
+                            "$ref": "#/definitions/handler.CreateACLsRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -65,8 +172,17 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -82,30 +198,102 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
                     }
                 }
-            }
-        },
-        "/api/v1/topics": {
-            "get": {
-                "description": "Get a list of all available Kafka topics",
+            },
+            "delete": {
+                "description": "Delete every ACL matching the given filters and return the deleted ACLs. The filter must set resourceName or principal, or a resourceType, patternType, operation or permission other than any or match, so that one call cannot delete every ACL.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "kafka"
+                    "acls"
+                ],
+                "summary": "Delete ACLs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "topic, group, cluster, transactionalid or delegationtoken",
+                        "name": "resourceType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Resource name",
+                        "name": "resourceName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "literal, prefixed or match",
+                        "name": "patternType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Principal, e.g. User:alice",
+                        "name": "principal",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Host",
+                        "name": "host",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Operation, e.g. read, write, describe or all",
+                        "name": "operation",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "allow or deny",
+                        "name": "permission",
+                        "in": "query"
+                    }
                 ],
-                "summary": "List all Kafka topics",
                 "responses": {
                     "200": {
                         "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ACLsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
-                                "type": "array",
-                                "items": {
-                                    "type": "string"
-                                }
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
@@ -117,44 +305,46 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/api/v1/topics/{topic}": {
-            "post": {
-                "description": "Create a new topic with specified partitions and replication factor",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/cluster": {
+            "get": {
+                "description": "Get the cluster ID, the controller and the ID, address and rack of every broker. With configs=true each broker's configuration is included; sensitive values are omitted.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "kafka"
+                    "cluster"
                 ],
-                "summary": "Create a new Kafka topic",
+                "summary": "Describe the cluster",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Topic name",
-                        "name": "topic",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Topic configuration",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.CreateTopicRequest"
-                        }
+                        "type": "boolean",
+                        "description": "Include broker configs",
+                        "name": "configs",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ClusterResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -162,8 +352,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -179,35 +369,52 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/api/v1/topics/{topic}/partitions": {
+        "/api/v1/cluster/log-dirs": {
             "get": {
-                "description": "Get partition information for a specific Kafka topic",
+                "description": "Get the log directories of each broker with the on-disk size and offset lag of every partition replica they hold. size is the total of the directory, partitionsSize that of the listed partitions. Offline directories carry an error.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "kafka"
+                    "cluster"
                 ],
-                "summary": "Get topic partitions",
+                "summary": "Describe broker log directories",
                 "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Broker IDs, all brokers if omitted",
+                        "name": "broker",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Topic name",
+                        "description": "Only report partitions of this topic",
                         "name": "topic",
-                        "in": "path",
-                        "required": true
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/handler.LogDirsResponse"
                         }
                     },
                     "400": {
@@ -219,6 +426,24 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -227,51 +452,2096 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/health": {
+        "/api/v1/clusters": {
             "get": {
-                "description": "Get the health status of the service",
+                "description": "Get the names of the configured Kafka clusters. Select one with the /api/v1/clusters/{cluster}/ path prefix or the X-Kafka-Cluster header; requests without either use the default cluster.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "health"
+                    "cluster"
                 ],
-                "summary": "Health check endpoint",
+                "summary": "List Kafka clusters",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handler.ClustersResponse"
                         }
                     }
                 }
             }
-        }
+        },
+        "/api/v1/consumer-groups": {
+            "get": {
+                "description": "Get every consumer group known to the cluster",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "consumer-groups"
+                ],
+                "summary": "List consumer groups",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/handler.ConsumerGroupSummaryResponse"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/consumer-groups/{group}": {
+            "get": {
+                "description": "Get the state of a consumer group and the partitions assigned to each member",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "consumer-groups"
+                ],
+                "summary": "Describe a consumer group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Consumer group ID",
+                        "name": "group",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ConsumerGroupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a consumer group and its committed offsets. Fails with 409 while the group has active members.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "consumer-groups"
+                ],
+                "summary": "Delete a consumer group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Consumer group ID",
+                        "name": "group",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/consumer-groups/{group}/offsets": {
+            "get": {
+                "description": "Get the committed offset, latest offset and lag of a consumer group for every partition it has committed on. Lag and latestOffset are -1 when the latest offset cannot be read.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "consumer-groups"
+                ],
+                "summary": "Get consumer group offsets and lag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Consumer group ID",
+                        "name": "group",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only report partitions of this topic",
+                        "name": "topic",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ConsumerGroupOffsetsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/consumer-groups/{group}/offsets/reset": {
+            "post": {
+                "description": "Move the committed offsets of a consumer group on a topic to the earliest or latest offset, the first offset at a timestamp, a shift from the current offset, or explicit per-partition offsets. Offsets are clamped to the retained range, except explicit ones which must fall inside it. Refused with 409 while the group has active members. With dryRun the planned offsets are returned without committing.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "consumer-groups"
+                ],
+                "summary": "Reset consumer group offsets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Consumer group ID",
+                        "name": "group",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reset strategy",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResetOffsetsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResetOffsetsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/publish/{topic}": {
+            "post": {
+                "description": "Publish a message to a specified Kafka topic. The partition is chosen by the topic's configured partitioner unless given explicitly. Headers configured in server.forward_headers are copied from the HTTP request into record headers.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "kafka"
+                ],
+                "summary": "Publish message to Kafka topic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Topic name",
+                        "name": "topic",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Message to publish",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.MessageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.PublishResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/publish/{topic}/batch": {
+            "post": {
+                "description": "Publish up to 1000 messages in one request. Returns 200 when every message was written and 207 with per-message results otherwise.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "kafka"
+                ],
+                "summary": "Publish a batch of messages to Kafka topic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Topic name",
+                        "name": "topic",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Messages to publish",
+                        "name": "batch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.BatchMessageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.BatchResponse"
+                        }
+                    },
+                    "207": {
+                        "description": "Multi-Status",
+                        "schema": {
+                            "$ref": "#/definitions/handler.BatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/topics": {
+            "get": {
+                "description": "Get a list of all available Kafka topics",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "kafka"
+                ],
+                "summary": "List all Kafka topics",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "array",
+                                "items": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/topics/{topic}": {
+            "get": {
+                "description": "Get the leader, replicas, in-sync replicas, offline replicas and earliest/latest offsets of every partition. Partitions whose ISR is smaller than their replica set are flagged as under-replicated. Offsets are -1 when a partition cannot be read.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "kafka"
+                ],
+                "summary": "Describe a Kafka topic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Topic name",
+                        "name": "topic",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TopicDescriptionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new topic with specified partitions and replication factor, optional config entries (e.g. cleanup.policy, retention.ms) and an optional manual replica assignment where entry i lists the brokers for partition i. With dryRun the request is validated by the controller without creating the topic.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "kafka"
+                ],
+                "summary": "Create a new Kafka topic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Topic name",
+                        "name": "topic",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Topic configuration",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateTopicRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a topic and all of its data",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "kafka"
+                ],
+                "summary": "Delete a Kafka topic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Topic name",
+                        "name": "topic",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/topics/{topic}/config": {
+            "get": {
+                "description": "Get every configuration entry of a topic, including defaults. Sensitive values are omitted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "kafka"
+                ],
+                "summary": "Describe topic configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Topic name",
+                        "name": "topic",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TopicConfigResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Incrementally alter topic configuration. Each entry sets, deletes (reverts to default), appends to or subtracts from one key; keys not listed are left unchanged.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "kafka"
+                ],
+                "summary": "Alter topic configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Topic name",
+                        "name": "topic",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Config alterations",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.AlterTopicConfigRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/topics/{topic}/offsets": {
+            "get": {
+                "description": "Get, for every partition, the earliest offset whose record timestamp is at or after the given time: the place to start a replay. When every record of a partition is older, the latest offset is returned and latest is true.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "kafka"
+                ],
+                "summary": "Look up offsets by timestamp",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Topic name",
+                        "name": "topic",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC 3339 time or milliseconds since the epoch",
+                        "name": "timestamp",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.OffsetsForTimestampResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/topics/{topic}/partitions": {
+            "get": {
+                "description": "Get partition information for a specific Kafka topic",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "kafka"
+                ],
+                "summary": "Get topic partitions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Topic name",
+                        "name": "topic",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Grow a topic to a new partition count, optionally assigning brokers to each new partition. The response carries a warning when the topic holds keyed records, since key-to-partition mapping changes.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "kafka"
+                ],
+                "summary": "Increase topic partitions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Topic name",
+                        "name": "topic",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New partition count",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreatePartitionsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreatePartitionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/topics/{topic}/partitions/{partition}/messages": {
+            "get": {
+                "description": "Read messages from a partition starting at the given offset (earliest if omitted)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "kafka"
+                ],
+                "summary": "Fetch messages from a topic partition",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Topic name",
+                        "name": "topic",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Partition ID",
+                        "name": "partition",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset to start reading from",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of messages to return (default 100, max 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.FetchMessagesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/topics/{topic}/stream": {
+            "get": {
+                "description": "Tail a topic as Server-Sent Events. Each event id encodes the next offset per partition; send it back as Last-Event-ID to resume.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "kafka"
+                ],
+                "summary": "Stream topic messages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Topic name",
+                        "name": "topic",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Resume cursor from a previous event, e.g. 0:42,1:17",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/topics/{topic}/truncate": {
+            "post": {
+                "description": "Delete the records of a topic before per-partition offsets, or before a timestamp on every partition. An offset of -1 deletes every record of the partition. Consumers keep working and resume from the new earliest offset.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "kafka"
+                ],
+                "summary": "Truncate a topic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Topic name",
+                        "name": "topic",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Offsets or timestamp to truncate before",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.TruncateTopicRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TruncateTopicResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/ws": {
+            "get": {
+                "description": "Upgrade to a WebSocket that accepts JSON frames of type subscribe, unsubscribe, publish and ack. Each frame is answered with an ack or error frame carrying its id, and subscribed records arrive as message frames. Subscriptions with a groupId join the consumer group, and client ack frames commit the offsets of processed records. Browser handshakes are refused with 403 unless they come from the gateway's own origin or one listed in server.websocket_origins.",
+                "tags": [
+                    "kafka"
+                ],
+                "summary": "WebSocket produce/consume channel",
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols",
+                        "schema": {
+                            "$ref": "#/definitions/handler.WebSocketResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Checks broker connectivity of every cluster by fetching cluster metadata. The status code and top-level fields follow the default cluster; clusters lists each cluster's controller, broker count and time of the last successful probe. The status is degraded while the default cluster is healthy and another is not. Clusters are probed concurrently, and one that does not answer within 800ms counts as unhealthy.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Readiness probe",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.HealthResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/handler.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health/live": {
+            "get": {
+                "description": "Reports that the gateway process is running. It does not check Kafka.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Liveness probe",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health/ready": {
+            "get": {
+                "description": "Checks broker connectivity of every cluster by fetching cluster metadata. The status code and top-level fields follow the default cluster; clusters lists each cluster's controller, broker count and time of the last successful probe. The status is degraded while the default cluster is healthy and another is not. Clusters are probed concurrently, and one that does not answer within 800ms counts as unhealthy.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Readiness probe",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.HealthResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/handler.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health/startup": {
+            "get": {
+                "description": "Succeeds once the gateway has made its initial connection to Kafka.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Startup probe",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.HealthResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/handler.HealthResponse"
+                        }
+                    }
+                }
+            }
+        }
     },
     "definitions": {
+        "handler.ACLRequest": {
+            "type": "object",
+            "required": [
+                "operation",
+                "permission",
+                "principal",
+                "resourceType"
+            ],
+            "properties": {
+                "host": {
+                    "type": "string",
+                    "example": "*"
+                },
+                "operation": {
+                    "type": "string",
+                    "example": "read"
+                },
+                "patternType": {
+                    "type": "string",
+                    "example": "literal"
+                },
+                "permission": {
+                    "type": "string",
+                    "example": "allow"
+                },
+                "principal": {
+                    "type": "string",
+                    "example": "User:billing-service"
+                },
+                "resourceName": {
+                    "type": "string",
+                    "example": "orders"
+                },
+                "resourceType": {
+                    "type": "string",
+                    "example": "topic"
+                }
+            }
+        },
+        "handler.ACLResponse": {
+            "type": "object",
+            "properties": {
+                "host": {
+                    "type": "string",
+                    "example": "*"
+                },
+                "operation": {
+                    "type": "string",
+                    "example": "read"
+                },
+                "patternType": {
+                    "type": "string",
+                    "example": "literal"
+                },
+                "permission": {
+                    "type": "string",
+                    "example": "allow"
+                },
+                "principal": {
+                    "type": "string",
+                    "example": "User:billing-service"
+                },
+                "resourceName": {
+                    "type": "string",
+                    "example": "orders"
+                },
+                "resourceType": {
+                    "type": "string",
+                    "example": "topic"
+                }
+            }
+        },
+        "handler.ACLsResponse": {
+            "type": "object",
+            "properties": {
+                "acls": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.ACLResponse"
+                    }
+                }
+            }
+        },
+        "handler.AlterTopicConfigRequest": {
+            "type": "object",
+            "required": [
+                "configs"
+            ],
+            "properties": {
+                "configs": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/handler.ConfigAlterationRequest"
+                    }
+                }
+            }
+        },
+        "handler.BatchMessageRequest": {
+            "type": "object",
+            "required": [
+                "messages"
+            ],
+            "properties": {
+                "messages": {
+                    "type": "array",
+                    "maxItems": 1000,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/handler.MessageRequest"
+                    }
+                }
+            }
+        },
+        "handler.BatchResponse": {
+            "type": "object",
+            "properties": {
+                "failureCount": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.BatchResult"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "successCount": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "topic": {
+                    "type": "string",
+                    "example": "my-topic"
+                }
+            }
+        },
+        "handler.BatchResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "offset": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "partition": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.BrokerResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "kafka-1:9092"
+                },
+                "configs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.ConfigEntryResponse"
+                    }
+                },
+                "controller": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "rack": {
+                    "type": "string",
+                    "example": "eu-west-1a"
+                }
+            }
+        },
+        "handler.ClusterHealthResponse": {
+            "type": "object",
+            "properties": {
+                "brokerCount": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "controllerId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "error": {
+                    "type": "string"
+                },
+                "lastProbe": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "healthy"
+                }
+            }
+        },
+        "handler.ClusterResponse": {
+            "type": "object",
+            "properties": {
+                "brokers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.BrokerResponse"
+                    }
+                },
+                "clusterId": {
+                    "type": "string",
+                    "example": "lkc-8x2mq0TJQpGZ4l1i7zVZ5A"
+                },
+                "controllerId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "handler.ClustersResponse": {
+            "type": "object",
+            "properties": {
+                "clusters": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "analytics",
+                        "default",
+                        "dr"
+                    ]
+                },
+                "default": {
+                    "type": "string",
+                    "example": "default"
+                }
+            }
+        },
+        "handler.ConfigAlterationRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "retention.ms"
+                },
+                "operation": {
+                    "type": "string",
+                    "enum": [
+                        "set",
+                        "delete",
+                        "append",
+                        "subtract"
+                    ],
+                    "example": "set"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "86400000"
+                }
+            }
+        },
+        "handler.ConfigEntryResponse": {
+            "type": "object",
+            "properties": {
+                "default": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "example": "retention.ms"
+                },
+                "readOnly": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "sensitive": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "source": {
+                    "type": "string",
+                    "example": "Default"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "604800000"
+                }
+            }
+        },
+        "handler.ConsumerGroupMemberResponse": {
+            "type": "object",
+            "properties": {
+                "assignment": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "clientHost": {
+                    "type": "string",
+                    "example": "/10.0.0.12"
+                },
+                "clientId": {
+                    "type": "string",
+                    "example": "sarama"
+                },
+                "memberId": {
+                    "type": "string",
+                    "example": "sarama-6f1c1a7e"
+                }
+            }
+        },
+        "handler.ConsumerGroupOffsetsResponse": {
+            "type": "object",
+            "properties": {
+                "groupId": {
+                    "type": "string",
+                    "example": "my-group"
+                },
+                "partitions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.PartitionLagResponse"
+                    }
+                },
+                "totalLag": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "handler.ConsumerGroupResponse": {
+            "type": "object",
+            "properties": {
+                "groupId": {
+                    "type": "string",
+                    "example": "my-group"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.ConsumerGroupMemberResponse"
+                    }
+                },
+                "protocol": {
+                    "type": "string",
+                    "example": "sticky"
+                },
+                "protocolType": {
+                    "type": "string",
+                    "example": "consumer"
+                },
+                "state": {
+                    "type": "string",
+                    "example": "Stable"
+                }
+            }
+        },
+        "handler.ConsumerGroupSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "groupId": {
+                    "type": "string",
+                    "example": "my-group"
+                },
+                "protocolType": {
+                    "type": "string",
+                    "example": "consumer"
+                }
+            }
+        },
+        "handler.CreateACLsRequest": {
+            "type": "object",
+            "required": [
+                "acls"
+            ],
+            "properties": {
+                "acls": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/handler.ACLRequest"
+                    }
+                }
+            }
+        },
+        "handler.CreatePartitionsRequest": {
+            "type": "object",
+            "required": [
+                "count"
+            ],
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 6
+                },
+                "dryRun": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "replicaAssignment": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                }
+            }
+        },
+        "handler.CreatePartitionsResponse": {
+            "type": "object",
+            "properties": {
+                "dryRun": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Partitions created successfully"
+                },
+                "partitionCount": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "previousCount": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "topic": {
+                    "type": "string",
+                    "example": "my-topic"
+                },
+                "warning": {
+                    "type": "string"
+                }
+            }
+        },
         "handler.CreateTopicRequest": {
             "type": "object",
-            "required": [
-                "numPartitions",
-                "replicationFactor"
-            ],
             "properties": {
-                "numPartitions": {
+                "configEntries": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "dryRun": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "numPartitions": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 3
+                },
+                "replicaAssignment": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "replicationFactor": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1
+                }
+            }
+        },
+        "handler.FetchMessagesResponse": {
+            "type": "object",
+            "properties": {
+                "highWatermark": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "messages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.MessageResponse"
+                    }
+                },
+                "nextOffset": {
+                    "type": "integer",
+                    "example": 43
+                },
+                "partition": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "topic": {
+                    "type": "string",
+                    "example": "my-topic"
+                }
+            }
+        },
+        "handler.HealthResponse": {
+            "type": "object",
+            "properties": {
+                "brokerCount": {
                     "type": "integer",
-                    "minimum": 1,
                     "example": 3
                 },
-                "replicationFactor": {
+                "clusters": {
+                    "description": "Clusters breaks readiness down by cluster, including the default one",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/handler.ClusterHealthResponse"
+                    }
+                },
+                "controllerId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "error": {
+                    "type": "string"
+                },
+                "lastProbe": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "healthy"
+                }
+            }
+        },
+        "handler.LogDirPartitionResponse": {
+            "type": "object",
+            "properties": {
+                "offsetLag": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "partition": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 1048576
+                },
+                "temporary": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "topic": {
+                    "type": "string",
+                    "example": "my-topic"
+                }
+            }
+        },
+        "handler.LogDirResponse": {
+            "type": "object",
+            "properties": {
+                "brokerId": {
                     "type": "integer",
-                    "minimum": 1,
                     "example": 1
+                },
+                "error": {
+                    "type": "string"
+                },
+                "partitions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.LogDirPartitionResponse"
+                    }
+                },
+                "partitionsSize": {
+                    "type": "integer",
+                    "example": 524288
+                },
+                "path": {
+                    "type": "string",
+                    "example": "/var/lib/kafka/data"
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 1048576
+                }
+            }
+        },
+        "handler.LogDirsResponse": {
+            "type": "object",
+            "properties": {
+                "logDirs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.LogDirResponse"
+                    }
                 }
             }
         },
@@ -281,15 +2551,415 @@ const docTemplate = `{
                 "value"
             ],
             "properties": {
+                "headers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "key": {
+                    "type": "string",
+                    "example": "user-123"
+                },
+                "partition": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 0
+                },
+                "value": {
+                    "type": "string",
+                    "example": "Hello, Kafka!"
+                }
+            }
+        },
+        "handler.MessageResponse": {
+            "type": "object",
+            "properties": {
+                "headers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
                 "key": {
                     "type": "string",
                     "example": "user-123"
                 },
+                "offset": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "partition": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "topic": {
+                    "type": "string",
+                    "example": "my-topic"
+                },
                 "value": {
                     "type": "string",
                     "example": "Hello, Kafka!"
                 }
             }
+        },
+        "handler.OffsetsForTimestampResponse": {
+            "type": "object",
+            "properties": {
+                "partitions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.TimestampOffsetResponse"
+                    }
+                },
+                "timestamp": {
+                    "type": "string",
+                    "example": "2024-05-01T09:00:00Z"
+                },
+                "topic": {
+                    "type": "string",
+                    "example": "my-topic"
+                }
+            }
+        },
+        "handler.PartitionDescriptionResponse": {
+            "type": "object",
+            "properties": {
+                "earliestOffset": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "isr": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "latestOffset": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "leader": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "offlineReplicas": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "partition": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "replicas": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "underReplicated": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "handler.PartitionLagResponse": {
+            "type": "object",
+            "properties": {
+                "committedOffset": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "lag": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "latestOffset": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "partition": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "topic": {
+                    "type": "string",
+                    "example": "my-topic"
+                }
+            }
+        },
+        "handler.PlannedOffsetResponse": {
+            "type": "object",
+            "properties": {
+                "currentOffset": {
+                    "type": "integer",
+                    "example": 1200
+                },
+                "newOffset": {
+                    "type": "integer",
+                    "example": 1100
+                },
+                "partition": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "handler.PublishResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Message published successfully"
+                },
+                "offset": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "partition": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "topic": {
+                    "type": "string",
+                    "example": "my-topic"
+                }
+            }
+        },
+        "handler.ResetOffsetsRequest": {
+            "type": "object",
+            "required": [
+                "strategy",
+                "topic"
+            ],
+            "properties": {
+                "dryRun": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "offsets": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "partitions": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "shift": {
+                    "type": "integer",
+                    "example": -100
+                },
+                "strategy": {
+                    "type": "string",
+                    "enum": [
+                        "earliest",
+                        "latest",
+                        "timestamp",
+                        "shift",
+                        "explicit"
+                    ],
+                    "example": "timestamp"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "topic": {
+                    "type": "string",
+                    "example": "my-topic"
+                }
+            }
+        },
+        "handler.ResetOffsetsResponse": {
+            "type": "object",
+            "properties": {
+                "dryRun": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "groupId": {
+                    "type": "string",
+                    "example": "my-group"
+                },
+                "partitions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.PlannedOffsetResponse"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "strategy": {
+                    "type": "string",
+                    "example": "timestamp"
+                },
+                "topic": {
+                    "type": "string",
+                    "example": "my-topic"
+                }
+            }
+        },
+        "handler.TimestampOffsetResponse": {
+            "type": "object",
+            "properties": {
+                "latest": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "offset": {
+                    "type": "integer",
+                    "example": 1042
+                },
+                "partition": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "handler.TopicConfigResponse": {
+            "type": "object",
+            "properties": {
+                "configs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.ConfigEntryResponse"
+                    }
+                },
+                "topic": {
+                    "type": "string",
+                    "example": "my-topic"
+                }
+            }
+        },
+        "handler.TopicDescriptionResponse": {
+            "type": "object",
+            "properties": {
+                "internal": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "partitionCount": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "partitions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.PartitionDescriptionResponse"
+                    }
+                },
+                "topic": {
+                    "type": "string",
+                    "example": "my-topic"
+                },
+                "underReplicatedPartitions": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "handler.TruncateTopicRequest": {
+            "type": "object",
+            "properties": {
+                "before": {
+                    "type": "string",
+                    "example": "2024-05-01T09:00:00Z"
+                },
+                "offsets": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "handler.TruncateTopicResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Topic truncated successfully"
+                },
+                "partitions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.TruncatedPartitionResponse"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "example": "success"
+                },
+                "topic": {
+                    "type": "string",
+                    "example": "my-topic"
+                }
+            }
+        },
+        "handler.TruncatedPartitionResponse": {
+            "type": "object",
+            "properties": {
+                "earliestOffset": {
+                    "type": "integer",
+                    "example": 1500
+                },
+                "partition": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "previousEarliestOffset": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "handler.WebSocketResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "1"
+                },
+                "message": {
+                    "$ref": "#/definitions/handler.MessageResponse"
+                },
+                "offset": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "partition": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "topic": {
+                    "type": "string",
+                    "example": "my-topic"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "ack"
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -306,7 +2976,7 @@ var SwaggerInfo = &swag.Spec{
 	Version:          "1.0",
 	Host:             "localhost:8080",
 	BasePath:         "/",
-	Schemes:          []string{},
+	Schemes:          []string{"https"},
 	Title:            "Kafka Gateway API",
 	Description:      "A REST API gateway for Apache Kafka operations",
 	InfoInstanceName: "swagger",
@@ -7,9 +7,12 @@ toolchain go1.24.0
 require (
 	github.com/Shopify/sarama v1.38.1
 	github.com/gin-gonic/gin v1.10.0
+	github.com/gorilla/websocket v1.5.3
 	github.com/prometheus/client_golang v1.17.0
 	github.com/spf13/viper v1.18.2
+	github.com/xdg-go/scram v1.1.2
 	go.uber.org/zap v1.26.0
+	golang.org/x/oauth2 v0.26.0
 )
 
 require (
@@ -83,6 +86,8 @@ require (
 	github.com/twitchyliquid64/golang-asm v0.15.1 // indirect
 	github.com/ugorji/go/codec v1.2.12 // indirect
 	github.com/urfave/cli/v2 v2.27.5 // indirect
+	github.com/xdg-go/pbkdf2 v1.0.0 // indirect
+	github.com/xdg-go/stringprep v1.0.4 // indirect
 	github.com/xrash/smetrics v0.0.0-20240521201337-686a1a2994c1 // indirect
 	go.uber.org/multierr v1.10.0 // indirect
 	golang.org/x/arch v0.14.0 // indirect
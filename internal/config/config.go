@@ -1,18 +1,30 @@
 package config
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/spf13/viper"
 )
 
 type Config struct {
 	Server ServerConfig `mapstructure:"server"`
 	Kafka  KafkaConfig  `mapstructure:"kafka"`
-	Auth   AuthConfig   `mapstructure:"auth"`
+	// Clusters holds additional named Kafka clusters. The kafka section is
+	// registered under DefaultCluster.
+	Clusters       map[string]KafkaConfig `mapstructure:"clusters"`
+	DefaultCluster string                 `mapstructure:"default_cluster"`
+	Auth           AuthConfig             `mapstructure:"auth"`
+	Tenants        []TenantConfig         `mapstructure:"tenants"`
 }
 
 type ServerConfig struct {
-	Address string    `mapstructure:"address"`
-	TLS     TLSConfig `mapstructure:"tls"`
+	Address        string    `mapstructure:"address"`
+	TLS            TLSConfig `mapstructure:"tls"`
+	ForwardHeaders []string  `mapstructure:"forward_headers"`
+	// WebSocketOrigins lists the browser origins, besides the gateway's own,
+	// that may open WebSocket connections.
+	WebSocketOrigins []string `mapstructure:"websocket_origins"`
 }
 
 type TLSConfig struct {
@@ -23,21 +35,115 @@ type TLSConfig struct {
 }
 
 type KafkaConfig struct {
-	Brokers          []string        `mapstructure:"brokers"`
-	ConsumerGroup    string          `mapstructure:"consumer_group"`
-	SecurityProtocol string          `mapstructure:"security_protocol"`
-	TLS              *KafkaTLSConfig `mapstructure:"tls"`
+	Brokers          []string          `mapstructure:"brokers"`
+	Version          string            `mapstructure:"version"`
+	ConsumerGroup    string            `mapstructure:"consumer_group"`
+	SecurityProtocol string            `mapstructure:"security_protocol"`
+	SASLMechanism    string            `mapstructure:"sasl_mechanism"`
+	SASLUsername     string            `mapstructure:"sasl_username"`
+	SASLPassword     string            `mapstructure:"sasl_password"`
+	OAuth            *KafkaOAuthConfig `mapstructure:"oauth"`
+	TLS              *KafkaTLSConfig   `mapstructure:"tls"`
+	Partitioner      string            `mapstructure:"partitioner"`
+	Topics           []TopicConfig     `mapstructure:"topics"`
+}
+
+// TopicConfig holds per-topic producer overrides.
+type TopicConfig struct {
+	Name        string `mapstructure:"name"`
+	Partitioner string `mapstructure:"partitioner"`
 }
 
+// KafkaOAuthConfig configures token fetching for SASL/OAUTHBEARER.
+type KafkaOAuthConfig struct {
+	Provider     string   `mapstructure:"provider"`
+	TokenURL     string   `mapstructure:"token_url"`
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	Scopes       []string `mapstructure:"scopes"`
+	Token        string   `mapstructure:"token"`
+}
+
+// KafkaTLSConfig configures TLS to the brokers. Each certificate can be given
+// as a file path or as inline PEM; inline PEM takes precedence.
 type KafkaTLSConfig struct {
-	CACert     string `mapstructure:"ca_cert"`
-	ClientCert string `mapstructure:"client_cert"`
-	ClientKey  string `mapstructure:"client_key"`
+	CACert             string `mapstructure:"ca_cert"`
+	ClientCert         string `mapstructure:"client_cert"`
+	ClientKey          string `mapstructure:"client_key"`
+	CACertPEM          string `mapstructure:"ca_cert_pem"`
+	ClientCertPEM      string `mapstructure:"client_cert_pem"`
+	ClientKeyPEM       string `mapstructure:"client_key_pem"`
+	ServerName         string `mapstructure:"server_name"`
+	InsecureSkipVerify bool   `mapstructure:"insecure_skip_verify"`
+}
+
+// Built-in defaults shared by the kafka section and every named cluster.
+const (
+	defaultKafkaVersion     = "2.8.0"
+	defaultConsumerGroup    = "kafka-gateway"
+	defaultSecurityProtocol = "PLAINTEXT"
+	defaultSASLMechanism    = "PLAIN"
+	defaultPartitioner      = "hash"
+)
+
+// KafkaClusters returns every configured cluster by name, including the kafka
+// section under DefaultCluster.
+func (c *Config) KafkaClusters() (map[string]KafkaConfig, error) {
+	clusters := make(map[string]KafkaConfig, len(c.Clusters)+1)
+	for name, cluster := range c.Clusters {
+		if name == c.DefaultCluster {
+			return nil, fmt.Errorf("cluster %q is defined in both kafka and clusters", name)
+		}
+		clusters[name] = cluster
+	}
+	clusters[c.DefaultCluster] = c.Kafka
+	return clusters, nil
+}
+
+// applyKafkaDefaults fills the settings viper defaults only cover for the
+// kafka section.
+func applyKafkaDefaults(cfg *KafkaConfig) {
+	if cfg.Version == "" {
+		cfg.Version = defaultKafkaVersion
+	}
+	if cfg.ConsumerGroup == "" {
+		cfg.ConsumerGroup = defaultConsumerGroup
+	}
+	if cfg.SecurityProtocol == "" {
+		cfg.SecurityProtocol = defaultSecurityProtocol
+	}
+	if cfg.SASLMechanism == "" {
+		cfg.SASLMechanism = defaultSASLMechanism
+	}
+	if cfg.Partitioner == "" {
+		cfg.Partitioner = defaultPartitioner
+	}
+	// Without a tls block, TLS uses the system roots and no client certificate
+	if cfg.TLS == nil {
+		cfg.TLS = &KafkaTLSConfig{}
+	}
 }
 
 type AuthConfig struct {
-	Enabled bool   `mapstructure:"enabled"`
-	Secret  string `mapstructure:"secret"`
+	Enabled            bool     `mapstructure:"enabled"`
+	Secret             string   `mapstructure:"secret"`
+	OperatorIdentities []string `mapstructure:"operator_identities"` // Client certificate common names with operator access while tenants are configured
+}
+
+// TenantConfig maps API keys and client certificate identities to a tenant
+// whose topics and consumer groups live under Namespace.
+type TenantConfig struct {
+	Name       string      `mapstructure:"name"`
+	Namespace  string      `mapstructure:"namespace"`  // Defaults to Name followed by "."
+	APIKeys    []string    `mapstructure:"api_keys"`   // Bearer tokens
+	Identities []string    `mapstructure:"identities"` // Client certificate common names
+	Quota      QuotaConfig `mapstructure:"quota"`
+}
+
+// QuotaConfig limits the request rate of a tenant. Zero means unlimited.
+type QuotaConfig struct {
+	RequestsPerSecond float64 `mapstructure:"requests_per_second"`
+	Burst             int     `mapstructure:"burst"`
 }
 
 func Load() (*Config, error) {
@@ -46,16 +152,55 @@ func Load() (*Config, error) {
 	viper.AddConfigPath(".")
 	viper.AddConfigPath("./config")
 
+	// Allow keys to be overridden from the environment, e.g.
+	// KAFKA_GATEWAY_KAFKA_TLS_CA_CERT_PEM for kafka.tls.ca_cert_pem. viper
+	// only looks up keys it knows of: those with a default below, those in
+	// the config file, and the credentials bound here, which have no default.
+	// Named clusters and tenants can only be set in the file.
+	viper.SetEnvPrefix("KAFKA_GATEWAY")
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	viper.AutomaticEnv()
+	for _, key := range []string{
+		"auth.secret",
+		"kafka.oauth.provider",
+		"kafka.oauth.token_url",
+		"kafka.oauth.client_id",
+		"kafka.oauth.client_secret",
+		"kafka.oauth.scopes",
+		"kafka.oauth.token",
+	} {
+		if err := viper.BindEnv(key); err != nil {
+			return nil, err
+		}
+	}
+
 	// Set defaults
 	viper.SetDefault("server.address", ":8080")
 	viper.SetDefault("server.tls.enabled", false)
 	viper.SetDefault("server.tls.ca_cert", "certs/ca/ca.crt")
 	viper.SetDefault("server.tls.server_cert", "certs/server/server.crt")
 	viper.SetDefault("server.tls.server_key", "certs/server/server.key")
+	viper.SetDefault("server.forward_headers", []string{})
+	viper.SetDefault("server.websocket_origins", []string{})
 	viper.SetDefault("kafka.brokers", []string{"localhost:9092"})
-	viper.SetDefault("kafka.consumer_group", "kafka-gateway")
-	viper.SetDefault("kafka.security_protocol", "PLAINTEXT")
+	viper.SetDefault("kafka.version", defaultKafkaVersion)
+	viper.SetDefault("kafka.consumer_group", defaultConsumerGroup)
+	viper.SetDefault("kafka.security_protocol", defaultSecurityProtocol)
+	viper.SetDefault("kafka.sasl_mechanism", defaultSASLMechanism)
+	viper.SetDefault("kafka.sasl_username", "")
+	viper.SetDefault("kafka.sasl_password", "")
+	viper.SetDefault("kafka.tls.ca_cert", "")
+	viper.SetDefault("kafka.tls.client_cert", "")
+	viper.SetDefault("kafka.tls.client_key", "")
+	viper.SetDefault("kafka.tls.ca_cert_pem", "")
+	viper.SetDefault("kafka.tls.client_cert_pem", "")
+	viper.SetDefault("kafka.tls.client_key_pem", "")
+	viper.SetDefault("kafka.tls.server_name", "")
+	viper.SetDefault("kafka.tls.insecure_skip_verify", false)
+	viper.SetDefault("kafka.partitioner", defaultPartitioner)
+	viper.SetDefault("default_cluster", "default")
 	viper.SetDefault("auth.enabled", false)
+	viper.SetDefault("auth.operator_identities", []string{})
 
 	// Read configuration
 	if err := viper.ReadInConfig(); err != nil {
@@ -66,6 +211,10 @@ func Load() (*Config, error) {
 	if err := viper.Unmarshal(&config); err != nil {
 		return nil, err
 	}
+	for name, cluster := range config.Clusters {
+		applyKafkaDefaults(&cluster)
+		config.Clusters[name] = cluster
+	}
 
 	return &config, nil
 }
@@ -0,0 +1,233 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+// loadConfig runs Load against configYAML from a fresh working directory.
+func loadConfig(t *testing.T, configYAML string) *Config {
+	t.Helper()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "config.yaml"), []byte(configYAML), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.Chdir(wd)
+		viper.Reset()
+	})
+
+	viper.Reset()
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	return cfg
+}
+
+const testConfigYAML = `
+kafka:
+  brokers: ["kafka:9092"]
+  tls:
+    ca_cert: "/etc/kafka/ca.crt"
+auth:
+  secret: "from-file"
+`
+
+func TestLoadEnvOverrides(t *testing.T) {
+	tests := []struct {
+		name  string
+		env   map[string]string
+		check func(*testing.T, *Config)
+	}{
+		{
+			name: "file values without overrides",
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.Kafka.TLS.CACert != "/etc/kafka/ca.crt" || cfg.Auth.Secret != "from-file" {
+					t.Fatalf("got ca_cert %q and secret %q", cfg.Kafka.TLS.CACert, cfg.Auth.Secret)
+				}
+			},
+		},
+		{
+			name: "key from the file",
+			env:  map[string]string{"KAFKA_GATEWAY_AUTH_SECRET": "from-env"},
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.Auth.Secret != "from-env" {
+					t.Fatalf("auth.secret = %q, want from-env", cfg.Auth.Secret)
+				}
+			},
+		},
+		{
+			name: "nested key with a default only",
+			env:  map[string]string{"KAFKA_GATEWAY_KAFKA_TLS_CA_CERT_PEM": "-----BEGIN CERTIFICATE-----"},
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.Kafka.TLS.CACertPEM != "-----BEGIN CERTIFICATE-----" {
+					t.Fatalf("kafka.tls.ca_cert_pem = %q", cfg.Kafka.TLS.CACertPEM)
+				}
+			},
+		},
+		{
+			name: "key absent from the file",
+			env: map[string]string{
+				"KAFKA_GATEWAY_KAFKA_OAUTH_PROVIDER":      "client_credentials",
+				"KAFKA_GATEWAY_KAFKA_OAUTH_CLIENT_SECRET": "from-env",
+			},
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.Kafka.OAuth == nil || cfg.Kafka.OAuth.Provider != "client_credentials" || cfg.Kafka.OAuth.ClientSecret != "from-env" {
+					t.Fatalf("kafka.oauth = %+v, want provider and client secret from the environment", cfg.Kafka.OAuth)
+				}
+			},
+		},
+		{
+			name: "unset bound key",
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.Kafka.OAuth != nil {
+					t.Fatalf("kafka.oauth = %+v, want nil", cfg.Kafka.OAuth)
+				}
+			},
+		},
+		{
+			name: "list",
+			env:  map[string]string{"KAFKA_GATEWAY_KAFKA_BROKERS": "a:9092,b:9092"},
+			check: func(t *testing.T, cfg *Config) {
+				if want := []string{"a:9092", "b:9092"}; !reflect.DeepEqual(cfg.Kafka.Brokers, want) {
+					t.Fatalf("kafka.brokers = %v, want %v", cfg.Kafka.Brokers, want)
+				}
+			},
+		},
+		{
+			name: "boolean",
+			env:  map[string]string{"KAFKA_GATEWAY_KAFKA_TLS_INSECURE_SKIP_VERIFY": "true"},
+			check: func(t *testing.T, cfg *Config) {
+				if !cfg.Kafka.TLS.InsecureSkipVerify {
+					t.Fatal("kafka.tls.insecure_skip_verify = false, want true")
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+			tt.check(t, loadConfig(t, testConfigYAML))
+		})
+	}
+}
+
+func TestKafkaClusters(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "default only",
+			cfg:  Config{DefaultCluster: "default", Kafka: KafkaConfig{Brokers: []string{"kafka:9092"}}},
+			want: []string{"default"},
+		},
+		{
+			name: "named clusters",
+			cfg: Config{
+				DefaultCluster: "primary",
+				Clusters:       map[string]KafkaConfig{"dr": {Brokers: []string{"dr-kafka:9092"}}},
+			},
+			want: []string{"dr", "primary"},
+		},
+		{
+			name: "default name reused",
+			cfg: Config{
+				DefaultCluster: "default",
+				Clusters:       map[string]KafkaConfig{"default": {}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.cfg.KafkaClusters()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("KafkaClusters() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("KafkaClusters() = %v, want %v", got, tt.want)
+			}
+			for _, name := range tt.want {
+				if _, ok := got[name]; !ok {
+					t.Fatalf("KafkaClusters() is missing %s", name)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadClusterDefaults(t *testing.T) {
+	cfg := loadConfig(t, `
+kafka:
+  brokers: ["kafka:9092"]
+clusters:
+  dr:
+    brokers: ["dr-kafka:9092"]
+    partitioner: "random"
+`)
+
+	dr, ok := cfg.Clusters["dr"]
+	if !ok {
+		t.Fatal("cluster dr is missing")
+	}
+	if dr.Version != defaultKafkaVersion || dr.ConsumerGroup != defaultConsumerGroup ||
+		dr.SecurityProtocol != defaultSecurityProtocol || dr.SASLMechanism != defaultSASLMechanism {
+		t.Fatalf("cluster dr did not get the kafka defaults: %+v", dr)
+	}
+	if dr.Partitioner != "random" {
+		t.Fatalf("cluster dr partitioner = %q, want random", dr.Partitioner)
+	}
+	if cfg.DefaultCluster != "default" {
+		t.Fatalf("default_cluster = %q, want default", cfg.DefaultCluster)
+	}
+}
+
+func TestLoadClusterWithoutTLSBlock(t *testing.T) {
+	cfg := loadConfig(t, `
+kafka:
+  brokers: ["kafka:9092"]
+clusters:
+  dr:
+    brokers: ["dr-kafka:9093"]
+    security_protocol: "SSL"
+`)
+
+	if cfg.Kafka.TLS == nil {
+		t.Fatal("kafka.tls is nil")
+	}
+	if dr := cfg.Clusters["dr"]; dr.TLS == nil || *dr.TLS != (KafkaTLSConfig{}) {
+		t.Fatalf("cluster dr tls = %+v, want an empty TLS config", dr.TLS)
+	}
+}
+
+func TestLoadAuthSecretFromEnv(t *testing.T) {
+	t.Setenv("KAFKA_GATEWAY_AUTH_SECRET", "from-env")
+	cfg := loadConfig(t, `
+kafka:
+  brokers: ["kafka:9092"]
+`)
+	if cfg.Auth.Secret != "from-env" {
+		t.Fatalf("auth.secret = %q, want from-env", cfg.Auth.Secret)
+	}
+}
@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"context"
+	"kafka-gateway/internal/kafka"
+	"kafka-gateway/internal/tenant"
+	pb "kafka-gateway/proto/gen"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newACLFilter(f *pb.ACLFilter) kafka.ACLFilter {
+	return kafka.ACLFilter{
+		ResourceType: f.ResourceType,
+		ResourceName: f.ResourceName,
+		PatternType:  f.PatternType,
+		Principal:    f.Principal,
+		Host:         f.Host,
+		Operation:    f.Operation,
+		Permission:   f.Permission,
+	}
+}
+
+func newPbACLs(acls []kafka.ACL) []*pb.ACL {
+	resp := make([]*pb.ACL, len(acls))
+	for i, a := range acls {
+		resp[i] = &pb.ACL{
+			ResourceType: a.ResourceType,
+			ResourceName: a.ResourceName,
+			PatternType:  a.PatternType,
+			Principal:    a.Principal,
+			Host:         a.Host,
+			Operation:    a.Operation,
+			Permission:   a.Permission,
+		}
+	}
+	return resp
+}
+
+func (s *Server) ListACLs(ctx context.Context, req *pb.ACLFilter) (*pb.ListACLsResponse, error) {
+	if err := tenant.FromContext(ctx).Forbid("ACL management"); err != nil {
+		return nil, toStatus(err)
+	}
+
+	acls, err := s.client(ctx).ListACLs(newACLFilter(req))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.ListACLsResponse{Acls: newPbACLs(acls)}, nil
+}
+
+func (s *Server) CreateACLs(ctx context.Context, req *pb.CreateACLsRequest) (*pb.CreateACLsResponse, error) {
+	if err := tenant.FromContext(ctx).Forbid("ACL management"); err != nil {
+		return nil, toStatus(err)
+	}
+	if len(req.Acls) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "acls are required")
+	}
+
+	acls := make([]kafka.ACL, len(req.Acls))
+	for i, a := range req.Acls {
+		acls[i] = kafka.ACL{
+			ResourceType: a.ResourceType,
+			ResourceName: a.ResourceName,
+			PatternType:  a.PatternType,
+			Principal:    a.Principal,
+			Host:         a.Host,
+			Operation:    a.Operation,
+			Permission:   a.Permission,
+		}
+	}
+
+	if err := s.client(ctx).CreateACLs(acls); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.CreateACLsResponse{
+		Status:  "success",
+		Message: "ACLs created successfully",
+		Count:   int32(len(acls)),
+	}, nil
+}
+
+func (s *Server) DeleteACLs(ctx context.Context, req *pb.ACLFilter) (*pb.DeleteACLsResponse, error) {
+	if err := tenant.FromContext(ctx).Forbid("ACL management"); err != nil {
+		return nil, toStatus(err)
+	}
+
+	deleted, err := s.client(ctx).DeleteACLs(newACLFilter(req))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.DeleteACLsResponse{Acls: newPbACLs(deleted)}, nil
+}
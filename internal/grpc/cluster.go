@@ -0,0 +1,70 @@
+package grpc
+
+import (
+	"context"
+	"kafka-gateway/internal/tenant"
+	pb "kafka-gateway/proto/gen"
+)
+
+func (s *Server) DescribeCluster(ctx context.Context, req *pb.DescribeClusterRequest) (*pb.DescribeClusterResponse, error) {
+	if err := tenant.FromContext(ctx).Forbid("cluster introspection"); err != nil {
+		return nil, toStatus(err)
+	}
+
+	cluster, err := s.client(ctx).DescribeCluster(req.IncludeConfigs)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.DescribeClusterResponse{
+		ClusterId:    cluster.ClusterID,
+		ControllerId: cluster.ControllerID,
+		Brokers:      make([]*pb.Broker, len(cluster.Brokers)),
+	}
+	for i, b := range cluster.Brokers {
+		resp.Brokers[i] = &pb.Broker{
+			Id:         b.ID,
+			Address:    b.Address,
+			Rack:       b.Rack,
+			Controller: b.Controller,
+		}
+		if req.IncludeConfigs {
+			resp.Brokers[i].Configs = newPbConfigEntries(b.Configs)
+		}
+	}
+	return resp, nil
+}
+
+func (s *Server) DescribeLogDirs(ctx context.Context, req *pb.DescribeLogDirsRequest) (*pb.DescribeLogDirsResponse, error) {
+	if err := tenant.FromContext(ctx).Forbid("cluster introspection"); err != nil {
+		return nil, toStatus(err)
+	}
+
+	logDirs, err := s.client(ctx).DescribeLogDirs(req.BrokerIds, req.Topic)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.DescribeLogDirsResponse{LogDirs: make([]*pb.LogDir, len(logDirs))}
+	for i, d := range logDirs {
+		partitions := make([]*pb.LogDirPartition, len(d.Partitions))
+		for j, p := range d.Partitions {
+			partitions[j] = &pb.LogDirPartition{
+				Topic:     p.Topic,
+				Partition: p.Partition,
+				Size:      p.Size,
+				OffsetLag: p.OffsetLag,
+				Temporary: p.Temporary,
+			}
+		}
+		resp.LogDirs[i] = &pb.LogDir{
+			BrokerId:       d.BrokerID,
+			Path:           d.Path,
+			Error:          d.Error,
+			Size:           d.Size,
+			PartitionsSize: d.PartitionsSize,
+			Partitions:     partitions,
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"context"
+	"kafka-gateway/internal/kafka"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ClusterMetadataKey selects the Kafka cluster of a call. Calls without it
+// use the default cluster.
+const ClusterMetadataKey = "x-kafka-cluster"
+
+type clusterContextKey struct{}
+
+// withCluster resolves the cluster named in the call metadata and stores its
+// client in the returned context.
+func withCluster(ctx context.Context, clusters *kafka.Registry) (context.Context, error) {
+	var name string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(ClusterMetadataKey); len(values) > 0 {
+			name = values[0]
+		}
+	}
+
+	client, err := clusters.Client(name)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return context.WithValue(ctx, clusterContextKey{}, client), nil
+}
+
+func clusterUnaryInterceptor(clusters *kafka.Registry) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := withCluster(ctx, clusters)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func clusterStreamInterceptor(clusters *kafka.Registry) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := withCluster(ss.Context(), clusters)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &clusterStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// clusterStream overrides the context of a stream with one carrying the
+// cluster or tenant.
+type clusterStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *clusterStream) Context() context.Context {
+	return s.ctx
+}
+
+// client returns the Kafka client of the cluster selected for the call.
+func (s *Server) client(ctx context.Context) *kafka.Client {
+	if client, ok := ctx.Value(clusterContextKey{}).(*kafka.Client); ok {
+		return client
+	}
+	return s.clusters.Default()
+}
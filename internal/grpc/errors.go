@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"errors"
+	"kafka-gateway/internal/kafka"
+	"kafka-gateway/internal/tenant"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatus maps an error from the Kafka client or tenancy checks to a gRPC status error.
+func toStatus(err error) error {
+	switch {
+	case kafka.IsUnavailable(err):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, tenant.ErrUnknownAPIKey):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, tenant.ErrForbidden),
+		errors.Is(err, tenant.ErrUnknownCaller):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, tenant.ErrQuotaExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, kafka.ErrInvalidPartition),
+		errors.Is(err, kafka.ErrPartitionRequired),
+		errors.Is(err, kafka.ErrOffsetOutOfRange),
+		errors.Is(err, kafka.ErrInvalidConfig),
+		errors.Is(err, kafka.ErrInvalidAssignment),
+		errors.Is(err, kafka.ErrInvalidPartitionCount),
+		errors.Is(err, kafka.ErrInvalidOffsetReset),
+		errors.Is(err, kafka.ErrInvalidTruncation),
+		errors.Is(err, kafka.ErrInvalidACL):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, kafka.ErrTopicNotFound),
+		errors.Is(err, kafka.ErrGroupNotFound),
+		errors.Is(err, kafka.ErrBrokerNotFound),
+		errors.Is(err, kafka.ErrClusterNotFound),
+		errors.Is(err, kafka.ErrSubscriptionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, kafka.ErrPartitionNotAssigned),
+		errors.Is(err, kafka.ErrGroupNotEmpty),
+		errors.Is(err, kafka.ErrSecurityDisabled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return err
+	}
+}
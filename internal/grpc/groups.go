@@ -0,0 +1,175 @@
+package grpc
+
+import (
+	"context"
+	"kafka-gateway/internal/kafka"
+	"kafka-gateway/internal/tenant"
+	pb "kafka-gateway/proto/gen"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *Server) ListConsumerGroups(ctx context.Context, _ *emptypb.Empty) (*pb.ListConsumerGroupsResponse, error) {
+	groups, err := s.client(ctx).ListConsumerGroups()
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	t := tenant.FromContext(ctx)
+	resp := &pb.ListConsumerGroupsResponse{
+		Groups: make([]*pb.ConsumerGroupSummary, 0, len(groups)),
+	}
+	for _, g := range groups {
+		if !t.Owns(g.GroupID) {
+			continue
+		}
+		resp.Groups = append(resp.Groups, &pb.ConsumerGroupSummary{
+			GroupId:      t.Local(g.GroupID),
+			ProtocolType: g.ProtocolType,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) DescribeConsumerGroup(ctx context.Context, req *pb.DescribeConsumerGroupRequest) (*pb.DescribeConsumerGroupResponse, error) {
+	if req.GroupId == "" {
+		return nil, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	t := tenant.FromContext(ctx)
+	group, err := s.client(ctx).DescribeConsumerGroup(t.Qualify(req.GroupId))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.DescribeConsumerGroupResponse{
+		GroupId:      req.GroupId,
+		State:        group.State,
+		ProtocolType: group.ProtocolType,
+		Protocol:     group.Protocol,
+		Members:      make([]*pb.ConsumerGroupMember, len(group.Members)),
+	}
+	for i, m := range group.Members {
+		member := &pb.ConsumerGroupMember{
+			MemberId:   m.MemberID,
+			ClientId:   m.ClientID,
+			ClientHost: m.ClientHost,
+		}
+		for topic, partitions := range m.Assignment {
+			// The group may also consume topics outside the tenant's namespace
+			if !t.Owns(topic) {
+				continue
+			}
+			member.Assignment = append(member.Assignment, &pb.TopicPartitions{
+				Topic:      t.Local(topic),
+				Partitions: partitions,
+			})
+		}
+		sort.Slice(member.Assignment, func(i, j int) bool {
+			return member.Assignment[i].Topic < member.Assignment[j].Topic
+		})
+		resp.Members[i] = member
+	}
+	return resp, nil
+}
+
+func (s *Server) GetConsumerGroupOffsets(ctx context.Context, req *pb.GetConsumerGroupOffsetsRequest) (*pb.GetConsumerGroupOffsetsResponse, error) {
+	if req.GroupId == "" {
+		return nil, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	t := tenant.FromContext(ctx)
+	topic := req.Topic
+	if topic != "" {
+		topic = t.Qualify(topic)
+	}
+
+	lags, err := s.client(ctx).ConsumerGroupLag(t.Qualify(req.GroupId), topic)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.GetConsumerGroupOffsetsResponse{
+		GroupId:    req.GroupId,
+		Partitions: make([]*pb.PartitionLag, 0, len(lags)),
+	}
+	for _, l := range lags {
+		// The group may also consume topics outside the tenant's namespace
+		if !t.Owns(l.Topic) {
+			continue
+		}
+		resp.Partitions = append(resp.Partitions, &pb.PartitionLag{
+			Topic:           t.Local(l.Topic),
+			Partition:       l.Partition,
+			CommittedOffset: l.CommittedOffset,
+			LatestOffset:    l.LatestOffset,
+			Lag:             l.Lag,
+		})
+		if l.Lag > 0 {
+			resp.TotalLag += l.Lag
+		}
+	}
+	return resp, nil
+}
+
+func (s *Server) DeleteConsumerGroup(ctx context.Context, req *pb.DeleteConsumerGroupRequest) (*pb.DeleteConsumerGroupResponse, error) {
+	if req.GroupId == "" {
+		return nil, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+
+	if err := s.client(ctx).DeleteConsumerGroup(tenant.FromContext(ctx).Qualify(req.GroupId)); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.DeleteConsumerGroupResponse{
+		Status:  "success",
+		Message: "Consumer group deleted successfully",
+		GroupId: req.GroupId,
+	}, nil
+}
+
+func (s *Server) ResetConsumerGroupOffsets(ctx context.Context, req *pb.ResetConsumerGroupOffsetsRequest) (*pb.ResetConsumerGroupOffsetsResponse, error) {
+	if req.GroupId == "" {
+		return nil, status.Error(codes.InvalidArgument, "group_id is required")
+	}
+	if req.Topic == "" {
+		return nil, status.Error(codes.InvalidArgument, "topic is required")
+	}
+
+	reset := kafka.OffsetReset{
+		Strategy:   req.Strategy,
+		Shift:      req.Shift,
+		Offsets:    req.Offsets,
+		Partitions: req.Partitions,
+		DryRun:     req.DryRun,
+	}
+	if req.Timestamp != nil {
+		reset.Timestamp = req.Timestamp.AsTime()
+	}
+
+	t := tenant.FromContext(ctx)
+	plan, err := s.client(ctx).ResetConsumerGroupOffsets(t.Qualify(req.GroupId), t.Qualify(req.Topic), reset)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.ResetConsumerGroupOffsetsResponse{
+		Status:     "success",
+		GroupId:    req.GroupId,
+		Topic:      req.Topic,
+		Strategy:   req.Strategy,
+		DryRun:     req.DryRun,
+		Partitions: make([]*pb.PlannedOffset, len(plan)),
+	}
+	for i, p := range plan {
+		resp.Partitions[i] = &pb.PlannedOffset{
+			Partition:     p.Partition,
+			CurrentOffset: p.CurrentOffset,
+			NewOffset:     p.NewOffset,
+		}
+	}
+	return resp, nil
+}
@@ -8,24 +8,41 @@ import (
 	"io/ioutil"
 	"kafka-gateway/internal/config"
 	"kafka-gateway/internal/kafka"
+	"kafka-gateway/internal/tenant"
 	pb "kafka-gateway/proto/gen"
 	"net"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// healthProbeInterval is how often Kafka connectivity is checked to update
+// the grpc.health.v1 serving status.
+const healthProbeInterval = 10 * time.Second
+
 type Server struct {
 	pb.UnimplementedKafkaGatewayServiceServer
-	kafkaClient *kafka.Client
-	grpcServer  *grpc.Server
-	config      *config.Config
+	clusters     *kafka.Registry
+	grpcServer   *grpc.Server
+	healthServer *health.Server
+	config       *config.Config
+	shutdown     chan struct{}
 }
 
-func NewServer(kafkaClient *kafka.Client, cfg *config.Config) *Server {
-	var opts []grpc.ServerOption
+func NewServer(clusters *kafka.Registry, tenants *tenant.Registry, cfg *config.Config) *Server {
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(clusterUnaryInterceptor(clusters), tenantUnaryInterceptor(tenants)),
+		grpc.ChainStreamInterceptor(clusterStreamInterceptor(clusters), tenantStreamInterceptor(tenants)),
+	}
 
 	if cfg.Server.TLS.Enabled {
 		// Load CA certificate
@@ -57,15 +74,64 @@ func NewServer(kafkaClient *kafka.Client, cfg *config.Config) *Server {
 
 	grpcServer := grpc.NewServer(opts...)
 	server := &Server{
-		kafkaClient: kafkaClient,
-		grpcServer:  grpcServer,
-		config:      cfg,
+		clusters:     clusters,
+		grpcServer:   grpcServer,
+		healthServer: health.NewServer(),
+		config:       cfg,
+		shutdown:     make(chan struct{}),
 	}
 	pb.RegisterKafkaGatewayServiceServer(grpcServer, server)
+	healthpb.RegisterHealthServer(grpcServer, server.healthServer)
 	reflection.Register(grpcServer)
+
+	// Not serving until the first successful Kafka probe
+	server.setServingStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	for _, name := range clusters.Names() {
+		server.healthServer.SetServingStatus(clusterServiceName(name), healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+	go server.monitorKafka()
 	return server
 }
 
+// monitorKafka keeps the grpc.health.v1 status of the overall server and of
+// KafkaGatewayService in line with connectivity to the default cluster, and
+// that of each cluster's service name (see clusterServiceName) in line with
+// its own connectivity, until shutdown.
+func (s *Server) monitorKafka() {
+	ticker := time.NewTicker(healthProbeInterval)
+	defer ticker.Stop()
+
+	for {
+		for name, result := range s.clusters.ProbeAll(kafka.ProbeTimeout) {
+			servingStatus := healthpb.HealthCheckResponse_SERVING
+			if result.Err != nil {
+				servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+			}
+			s.healthServer.SetServingStatus(clusterServiceName(name), servingStatus)
+			if name == s.clusters.DefaultName() {
+				s.setServingStatus(servingStatus)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-s.shutdown:
+			return
+		}
+	}
+}
+
+// clusterServiceName is the grpc.health.v1 service name reporting the
+// connectivity of one cluster, e.g. kafka.gateway.v1.KafkaGatewayService/analytics.
+func clusterServiceName(cluster string) string {
+	return pb.KafkaGatewayService_ServiceDesc.ServiceName + "/" + cluster
+}
+
+func (s *Server) setServingStatus(servingStatus healthpb.HealthCheckResponse_ServingStatus) {
+	s.healthServer.SetServingStatus("", servingStatus)
+	s.healthServer.SetServingStatus(pb.KafkaGatewayService_ServiceDesc.ServiceName, servingStatus)
+}
+
 func (s *Server) Start(port int) error {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
@@ -80,49 +146,146 @@ func (s *Server) Start(port int) error {
 }
 
 func (s *Server) Stop() {
+	// Release open subscription streams so GracefulStop does not wait on them
+	close(s.shutdown)
+	// Report NOT_SERVING to health watchers and ignore later updates
+	s.healthServer.Shutdown()
 	s.grpcServer.GracefulStop()
 }
 
 // Service implementations
 func (s *Server) HealthCheck(ctx context.Context, _ *emptypb.Empty) (*pb.HealthCheckResponse, error) {
-	return &pb.HealthCheckResponse{
-		Status: "healthy",
-	}, nil
+	health, err := s.client(ctx).Probe()
+
+	resp := &pb.HealthCheckResponse{Status: "healthy"}
+	if !health.LastSuccess.IsZero() {
+		resp.LastProbe = timestamppb.New(health.LastSuccess)
+	}
+	if err != nil {
+		resp.Status = "unhealthy"
+		resp.Error = err.Error()
+		return resp, nil
+	}
+
+	resp.ControllerId = health.ControllerID
+	resp.BrokerCount = int32(health.BrokerCount)
+	return resp, nil
 }
 
 func (s *Server) PublishMessage(ctx context.Context, req *pb.PublishMessageRequest) (*pb.PublishMessageResponse, error) {
-	var key []byte
-	if req.Message.Key != "" {
-		key = []byte(req.Message.Key)
+	if req.Message == nil {
+		return nil, status.Error(codes.InvalidArgument, "message is required")
 	}
-
-	err := s.kafkaClient.PublishMessage(req.Topic, key, []byte(req.Message.Value))
+	result, err := s.client(ctx).PublishMessage(tenant.FromContext(ctx).Qualify(req.Topic), s.newKafkaMessage(ctx, req.Message))
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	return &pb.PublishMessageResponse{
-		Status:  "success",
-		Message: "Message published successfully",
-		Topic:   req.Topic,
+		Status:    "success",
+		Message:   "Message published successfully",
+		Topic:     req.Topic,
+		Partition: result.Partition,
+		Offset:    result.Offset,
+		Timestamp: timestamppb.New(result.Timestamp),
 	}, nil
 }
 
+func (s *Server) PublishBatch(ctx context.Context, req *pb.PublishBatchRequest) (*pb.PublishBatchResponse, error) {
+	if len(req.Messages) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one message is required")
+	}
+	if len(req.Messages) > kafka.MaxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch exceeds the maximum of %d messages", kafka.MaxBatchSize)
+	}
+
+	messages := make([]*kafka.Message, len(req.Messages))
+	for i, m := range req.Messages {
+		if m == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "message %d is empty", i)
+		}
+		messages[i] = s.newKafkaMessage(ctx, m)
+	}
+
+	results, err := s.client(ctx).PublishBatch(tenant.FromContext(ctx).Qualify(req.Topic), messages)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.PublishBatchResponse{
+		Topic:   req.Topic,
+		Results: make([]*pb.PublishBatchResult, len(results)),
+	}
+	for i, r := range results {
+		if r.Err != nil {
+			resp.Results[i] = &pb.PublishBatchResult{Status: "error", Error: r.Err.Error()}
+			resp.FailureCount++
+			continue
+		}
+		resp.Results[i] = &pb.PublishBatchResult{
+			Status:    "success",
+			Partition: r.Partition,
+			Offset:    r.Offset,
+			Timestamp: timestamppb.New(r.Timestamp),
+		}
+		resp.SuccessCount++
+	}
+	resp.Status = batchStatus(resp.SuccessCount, resp.FailureCount)
+
+	return resp, nil
+}
+
+// newKafkaMessage converts a proto message, copying the metadata entries listed
+// in server.forward_headers into record headers. Message headers take precedence.
+func (s *Server) newKafkaMessage(ctx context.Context, m *pb.Message) *kafka.Message {
+	msg := &kafka.Message{
+		Value:           []byte(m.GetValue()),
+		Headers:         make(map[string]string),
+		TargetPartition: m.Partition,
+	}
+	if m.GetKey() != "" {
+		msg.Key = []byte(m.GetKey())
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, name := range s.config.Server.ForwardHeaders {
+			if values := md.Get(name); len(values) > 0 {
+				msg.Headers[name] = values[0]
+			}
+		}
+	}
+	for k, v := range m.GetHeaders() {
+		msg.Headers[k] = v
+	}
+	return msg
+}
+
+func batchStatus(succeeded, failed int32) string {
+	switch {
+	case failed == 0:
+		return "success"
+	case succeeded == 0:
+		return "failed"
+	default:
+		return "partial"
+	}
+}
+
 func (s *Server) ListTopics(ctx context.Context, _ *emptypb.Empty) (*pb.ListTopicsResponse, error) {
-	topics, err := s.kafkaClient.ListTopics()
+	topics, err := s.client(ctx).ListTopics()
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	return &pb.ListTopicsResponse{
-		Topics: topics,
+		Topics: tenant.FromContext(ctx).LocalNames(topics),
 	}, nil
 }
 
 func (s *Server) GetTopicPartitions(ctx context.Context, req *pb.GetTopicPartitionsRequest) (*pb.GetTopicPartitionsResponse, error) {
-	partitions, err := s.kafkaClient.GetTopicPartitions(req.Topic)
+	partitions, err := s.client(ctx).GetTopicPartitions(tenant.FromContext(ctx).Qualify(req.Topic))
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	return &pb.GetTopicPartitionsResponse{
@@ -131,19 +294,319 @@ func (s *Server) GetTopicPartitions(ctx context.Context, req *pb.GetTopicPartiti
 	}, nil
 }
 
-func (s *Server) CreateTopic(ctx context.Context, req *pb.CreateTopicRequest) (*pb.CreateTopicResponse, error) {
-	err := s.kafkaClient.CreateTopic(
-		req.Topic,
-		req.Config.NumPartitions,
-		int16(req.Config.ReplicationFactor),
-	)
+func (s *Server) DescribeTopic(ctx context.Context, req *pb.DescribeTopicRequest) (*pb.DescribeTopicResponse, error) {
+	if req.Topic == "" {
+		return nil, status.Error(codes.InvalidArgument, "topic is required")
+	}
+
+	desc, err := s.client(ctx).DescribeTopic(tenant.FromContext(ctx).Qualify(req.Topic))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	partitions := make([]*pb.PartitionDescription, len(desc.Partitions))
+	for i, p := range desc.Partitions {
+		partitions[i] = &pb.PartitionDescription{
+			Partition:       p.ID,
+			Leader:          p.Leader,
+			Replicas:        p.Replicas,
+			Isr:             p.ISR,
+			OfflineReplicas: p.OfflineReplicas,
+			EarliestOffset:  p.EarliestOffset,
+			LatestOffset:    p.LatestOffset,
+			UnderReplicated: p.UnderReplicated,
+		}
+	}
+
+	return &pb.DescribeTopicResponse{
+		Topic:                     req.Topic,
+		Internal:                  desc.Internal,
+		PartitionCount:            int32(len(partitions)),
+		UnderReplicatedPartitions: int32(desc.UnderReplicatedPartitions),
+		Partitions:                partitions,
+	}, nil
+}
+
+func (s *Server) CreatePartitions(ctx context.Context, req *pb.CreatePartitionsRequest) (*pb.CreatePartitionsResponse, error) {
+	if req.Count < 1 {
+		return nil, status.Error(codes.InvalidArgument, "count must be positive")
+	}
+
+	var assignment [][]int32
+	for _, a := range req.ReplicaAssignment {
+		assignment = append(assignment, a.BrokerIds)
+	}
+
+	result, err := s.client(ctx).CreatePartitions(tenant.FromContext(ctx).Qualify(req.Topic), req.Count, assignment, req.DryRun)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
+	resp := &pb.CreatePartitionsResponse{
+		Status:         "success",
+		Message:        "Partitions created successfully",
+		Topic:          req.Topic,
+		PreviousCount:  result.PreviousCount,
+		PartitionCount: result.Count,
+		DryRun:         req.DryRun,
+	}
+	if req.DryRun {
+		resp.Message = "Partition increase validated successfully"
+	}
+	if result.KeyedData {
+		resp.Warning = kafka.KeyedDataWarning
+	}
+	return resp, nil
+}
+
+func (s *Server) CreateTopic(ctx context.Context, req *pb.CreateTopicRequest) (*pb.CreateTopicResponse, error) {
+	if req.Config == nil {
+		return nil, status.Error(codes.InvalidArgument, "config is required")
+	}
+
+	spec := kafka.TopicSpec{
+		NumPartitions:     req.Config.NumPartitions,
+		ReplicationFactor: int16(req.Config.ReplicationFactor),
+		ConfigEntries:     req.Config.ConfigEntries,
+		ValidateOnly:      req.DryRun,
+	}
+	for _, a := range req.Config.ReplicaAssignment {
+		spec.ReplicaAssignment = append(spec.ReplicaAssignment, a.BrokerIds)
+	}
+	if len(spec.ReplicaAssignment) == 0 && (spec.NumPartitions < 1 || spec.ReplicationFactor < 1) {
+		return nil, status.Error(codes.InvalidArgument, "num_partitions and replication_factor are required without a replica assignment")
+	}
+
+	if err := s.client(ctx).CreateTopic(tenant.FromContext(ctx).Qualify(req.Topic), spec); err != nil {
+		return nil, toStatus(err)
+	}
+
+	message := "Topic created successfully"
+	if req.DryRun {
+		message = "Topic creation validated successfully"
+	}
 	return &pb.CreateTopicResponse{
 		Status:  "success",
-		Message: "Topic created successfully",
+		Message: message,
+		Topic:   req.Topic,
+		DryRun:  req.DryRun,
+	}, nil
+}
+
+func (s *Server) FetchMessages(ctx context.Context, req *pb.FetchMessagesRequest) (*pb.FetchMessagesResponse, error) {
+	offset := int64(-1)
+	if req.Offset != nil {
+		offset = *req.Offset
+	}
+
+	result, err := s.client(ctx).FetchMessages(tenant.FromContext(ctx).Qualify(req.Topic), req.Partition, offset, int(req.Limit))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	messages := make([]*pb.ConsumedMessage, len(result.Messages))
+	for i, msg := range result.Messages {
+		messages[i] = newConsumedMessage(tenant.FromContext(ctx), msg)
+	}
+
+	return &pb.FetchMessagesResponse{
+		Topic:         req.Topic,
+		Partition:     req.Partition,
+		Messages:      messages,
+		NextOffset:    result.NextOffset,
+		HighWatermark: result.HighWatermark,
+	}, nil
+}
+
+func (s *Server) Subscribe(req *pb.SubscribeRequest, stream pb.KafkaGatewayService_SubscribeServer) error {
+	if len(req.Topics) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one topic is required")
+	}
+
+	// Tenants name their group, as the configured default is shared by all
+	t := tenant.FromContext(stream.Context())
+	groupID, topics := req.GroupId, req.Topics
+	if t != nil {
+		if groupID == "" {
+			return status.Error(codes.InvalidArgument, "group_id is required")
+		}
+		groupID = t.Qualify(groupID)
+		topics = make([]string, len(req.Topics))
+		for i, topic := range req.Topics {
+			topics[i] = t.Qualify(topic)
+		}
+	}
+
+	sub, err := s.client(stream.Context()).Subscribe(groupID, topics, req.FromBeginning)
+	if err != nil {
+		return toStatus(err)
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case msg, ok := <-sub.Messages():
+			if !ok {
+				return sub.Err()
+			}
+			if err := stream.Send(&pb.SubscribeResponse{
+				SubscriptionId: sub.ID,
+				GroupId:        t.Local(sub.GroupID),
+				Message:        newConsumedMessage(t, msg),
+			}); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		case <-s.shutdown:
+			return status.Error(codes.Unavailable, "server is shutting down")
+		}
+	}
+}
+
+func (s *Server) AckMessage(ctx context.Context, req *pb.AckMessageRequest) (*pb.AckMessageResponse, error) {
+	err := s.client(ctx).Ack(req.SubscriptionId, tenant.FromContext(ctx).Qualify(req.Topic), req.Partition, req.Offset)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.AckMessageResponse{
+		Status: "success",
+	}, nil
+}
+
+// newConsumedMessage converts a record, showing its topic as t sees it.
+func newConsumedMessage(t *tenant.Tenant, msg *kafka.Message) *pb.ConsumedMessage {
+	return &pb.ConsumedMessage{
+		Topic:     t.Local(msg.Topic),
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Key:       string(msg.Key),
+		Value:     string(msg.Value),
+		Headers:   msg.Headers,
+		Timestamp: timestamppb.New(msg.Timestamp),
+	}
+}
+
+func (s *Server) DeleteTopic(ctx context.Context, req *pb.DeleteTopicRequest) (*pb.DeleteTopicResponse, error) {
+	if req.Topic == "" {
+		return nil, status.Error(codes.InvalidArgument, "topic is required")
+	}
+
+	if err := s.client(ctx).DeleteTopic(tenant.FromContext(ctx).Qualify(req.Topic)); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.DeleteTopicResponse{
+		Status:  "success",
+		Message: "Topic deleted successfully",
+		Topic:   req.Topic,
+	}, nil
+}
+
+func (s *Server) GetOffsetsForTimestamp(ctx context.Context, req *pb.GetOffsetsForTimestampRequest) (*pb.GetOffsetsForTimestampResponse, error) {
+	if req.Topic == "" {
+		return nil, status.Error(codes.InvalidArgument, "topic is required")
+	}
+	if req.Timestamp == nil {
+		return nil, status.Error(codes.InvalidArgument, "timestamp is required")
+	}
+
+	offsets, err := s.client(ctx).OffsetsForTimestamp(tenant.FromContext(ctx).Qualify(req.Topic), req.Timestamp.AsTime())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.GetOffsetsForTimestampResponse{
+		Topic:      req.Topic,
+		Timestamp:  req.Timestamp,
+		Partitions: make([]*pb.TimestampOffset, len(offsets)),
+	}
+	for i, o := range offsets {
+		resp.Partitions[i] = &pb.TimestampOffset{
+			Partition: o.Partition,
+			Offset:    o.Offset,
+			Latest:    o.Latest,
+		}
+	}
+	return resp, nil
+}
+
+func (s *Server) TruncateTopic(ctx context.Context, req *pb.TruncateTopicRequest) (*pb.TruncateTopicResponse, error) {
+	if req.Topic == "" {
+		return nil, status.Error(codes.InvalidArgument, "topic is required")
+	}
+
+	var before time.Time
+	if req.Before != nil {
+		before = req.Before.AsTime()
+	}
+
+	truncated, err := s.client(ctx).TruncateTopic(tenant.FromContext(ctx).Qualify(req.Topic), req.Offsets, before)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.TruncateTopicResponse{
+		Status:     "success",
+		Message:    "Topic truncated successfully",
+		Topic:      req.Topic,
+		Partitions: make([]*pb.TruncatedPartition, len(truncated)),
+	}
+	for i, t := range truncated {
+		resp.Partitions[i] = &pb.TruncatedPartition{
+			Partition:              t.Partition,
+			PreviousEarliestOffset: t.PreviousEarliestOffset,
+			EarliestOffset:         t.EarliestOffset,
+		}
+	}
+	return resp, nil
+}
+
+func (s *Server) DescribeTopicConfig(ctx context.Context, req *pb.DescribeTopicConfigRequest) (*pb.DescribeTopicConfigResponse, error) {
+	entries, err := s.client(ctx).DescribeTopicConfig(tenant.FromContext(ctx).Qualify(req.Topic))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.DescribeTopicConfigResponse{
+		Topic:   req.Topic,
+		Configs: newPbConfigEntries(entries),
+	}, nil
+}
+
+func newPbConfigEntries(entries []kafka.ConfigEntry) []*pb.ConfigEntry {
+	configs := make([]*pb.ConfigEntry, len(entries))
+	for i, e := range entries {
+		configs[i] = &pb.ConfigEntry{
+			Name:      e.Name,
+			Value:     e.Value,
+			Default:   e.Default,
+			ReadOnly:  e.ReadOnly,
+			Sensitive: e.Sensitive,
+			Source:    e.Source,
+		}
+	}
+	return configs
+}
+
+func (s *Server) AlterTopicConfig(ctx context.Context, req *pb.AlterTopicConfigRequest) (*pb.AlterTopicConfigResponse, error) {
+	alterations := make([]kafka.ConfigAlteration, len(req.Configs))
+	for i, a := range req.Configs {
+		alterations[i] = kafka.ConfigAlteration{
+			Name:      a.Name,
+			Value:     a.Value,
+			Operation: a.Operation,
+		}
+	}
+
+	if err := s.client(ctx).AlterTopicConfig(tenant.FromContext(ctx).Qualify(req.Topic), alterations); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.AlterTopicConfigResponse{
+		Status:  "success",
+		Message: "Topic config altered successfully",
 		Topic:   req.Topic,
 	}, nil
 }
@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"context"
+	"crypto/x509"
+	"kafka-gateway/internal/tenant"
+	"strings"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// healthMethodPrefix names the grpc.health.v1 methods, which probes call
+// without credentials.
+var healthMethodPrefix = "/" + healthpb.Health_ServiceDesc.ServiceName + "/"
+
+// withTenant resolves the tenant of a call from its bearer token or client
+// certificate, charges the call to the tenant's quota and stores the tenant
+// in the returned context. Calls from neither a tenant nor an operator are
+// rejected.
+func withTenant(ctx context.Context, tenants *tenant.Registry, method string) (context.Context, error) {
+	if !tenants.Enabled() || strings.HasPrefix(method, healthMethodPrefix) {
+		return ctx, nil
+	}
+
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			token = strings.TrimPrefix(values[0], "Bearer ")
+		}
+	}
+	var peerCerts []*x509.Certificate
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			peerCerts = info.State.PeerCertificates
+		}
+	}
+
+	t, err := tenants.Resolve(token, peerCerts)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if t == nil {
+		return ctx, nil
+	}
+	if err := t.Allow(); err != nil {
+		return nil, toStatus(err)
+	}
+	return tenant.NewContext(ctx, t), nil
+}
+
+func tenantUnaryInterceptor(tenants *tenant.Registry) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := withTenant(ctx, tenants, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func tenantStreamInterceptor(tenants *tenant.Registry) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := withTenant(ss.Context(), tenants, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &clusterStream{ServerStream: ss, ctx: ctx})
+	}
+}
@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+	"kafka-gateway/internal/config"
+	"kafka-gateway/internal/tenant"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestWithTenant(t *testing.T) {
+	tenants, err := tenant.NewRegistry([]config.TenantConfig{
+		{Name: "billing", APIKeys: []string{"billing-key"}},
+	}, config.AuthConfig{Secret: "operator-key"})
+	if err != nil {
+		t.Fatalf("NewRegistry: %v", err)
+	}
+	const listTopics = "/kafka.gateway.v1.KafkaGatewayService/ListTopics"
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		wantCode      codes.Code
+		wantTenant    string
+	}{
+		{name: "tenant", method: listTopics, authorization: "Bearer billing-key", wantTenant: "billing"},
+		{name: "operator", method: listTopics, authorization: "Bearer operator-key"},
+		{name: "unknown token", method: listTopics, authorization: "Bearer bogus", wantCode: codes.Unauthenticated},
+		{name: "no credentials", method: listTopics, wantCode: codes.PermissionDenied},
+		{name: "health check without credentials", method: "/grpc.health.v1.Health/Check"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.authorization))
+			}
+
+			ctx, err := withTenant(ctx, tenants, tt.method)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("withTenant() error = %v, want code %v", err, tt.wantCode)
+			}
+			if err != nil {
+				return
+			}
+			got := tenant.FromContext(ctx)
+			if tt.wantTenant == "" && got != nil {
+				t.Fatalf("withTenant() tenant = %s, want none", got.Name)
+			}
+			if tt.wantTenant != "" && (got == nil || got.Name != tt.wantTenant) {
+				t.Fatalf("withTenant() tenant = %v, want %s", got, tt.wantTenant)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"kafka-gateway/internal/kafka"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ACLRequest struct {
+	ResourceType string `json:"resourceType" binding:"required" example:"topic"`
+	ResourceName string `json:"resourceName,omitempty" example:"orders"`
+	PatternType  string `json:"patternType,omitempty" example:"literal"`
+	Principal    string `json:"principal" binding:"required" example:"User:billing-service"`
+	Host         string `json:"host,omitempty" example:"*"`
+	Operation    string `json:"operation" binding:"required" example:"read"`
+	Permission   string `json:"permission" binding:"required" example:"allow"`
+}
+
+type CreateACLsRequest struct {
+	ACLs []ACLRequest `json:"acls" binding:"required,min=1,dive"`
+}
+
+type ACLResponse struct {
+	ResourceType string `json:"resourceType" example:"topic"`
+	ResourceName string `json:"resourceName" example:"orders"`
+	PatternType  string `json:"patternType" example:"literal"`
+	Principal    string `json:"principal" example:"User:billing-service"`
+	Host         string `json:"host" example:"*"`
+	Operation    string `json:"operation" example:"read"`
+	Permission   string `json:"permission" example:"allow"`
+}
+
+type ACLsResponse struct {
+	ACLs []ACLResponse `json:"acls"`
+}
+
+func newACLResponses(acls []kafka.ACL) []ACLResponse {
+	resp := make([]ACLResponse, len(acls))
+	for i, a := range acls {
+		resp[i] = ACLResponse{
+			ResourceType: a.ResourceType,
+			ResourceName: a.ResourceName,
+			PatternType:  a.PatternType,
+			Principal:    a.Principal,
+			Host:         a.Host,
+			Operation:    a.Operation,
+			Permission:   a.Permission,
+		}
+	}
+	return resp
+}
+
+// aclFilterFromQuery reads an ACL filter from the query string. Absent
+// parameters match every ACL.
+func aclFilterFromQuery(c *gin.Context) kafka.ACLFilter {
+	return kafka.ACLFilter{
+		ResourceType: c.Query("resourceType"),
+		ResourceName: c.Query("resourceName"),
+		PatternType:  c.Query("patternType"),
+		Principal:    c.Query("principal"),
+		Host:         c.Query("host"),
+		Operation:    c.Query("operation"),
+		Permission:   c.Query("permission"),
+	}
+}
+
+// @Summary List ACLs
+// @Description List the ACLs matching every given filter. Omitted filters match any value; patternType=match selects the literal, wildcard and prefixed ACLs that apply to resourceName.
+// @Tags acls
+// @Produce json
+// @Param resourceType query string false "topic, group, cluster, transactionalid or delegationtoken"
+// @Param resourceName query string false "Resource name"
+// @Param patternType query string false "literal, prefixed or match"
+// @Param principal query string false "Principal, e.g. User:alice"
+// @Param host query string false "Host"
+// @Param operation query string false "Operation, e.g. read, write, describe or all"
+// @Param permission query string false "allow or deny"
+// @Success 200 {object} ACLsResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v1/acls [get]
+func ListACLs(client *kafka.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		acls, err := client.ListACLs(aclFilterFromQuery(c))
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, ACLsResponse{ACLs: newACLResponses(acls)})
+	}
+}
+
+// @Summary Create ACLs
+// @Description Create one or more ACLs. patternType defaults to literal, host to * and the resource name of cluster ACLs to kafka-cluster. Existing ACLs are left unchanged. Fails with 409 when the cluster has no authorizer.
+// @Tags acls
+// @Accept json
+// @Produce json
+// @Param request body CreateACLsRequest true "ACLs to create"
+// @Success 201 {object} map[string]interface{}
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v1/acls [post]
+func CreateACLs(client *kafka.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req CreateACLsRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		acls := make([]kafka.ACL, len(req.ACLs))
+		for i, a := range req.ACLs {
+			acls[i] = kafka.ACL{
+				ResourceType: a.ResourceType,
+				ResourceName: a.ResourceName,
+				PatternType:  a.PatternType,
+				Principal:    a.Principal,
+				Host:         a.Host,
+				Operation:    a.Operation,
+				Permission:   a.Permission,
+			}
+		}
+
+		if err := client.CreateACLs(acls); err != nil {
+			respondError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, gin.H{
+			"status":  "success",
+			"message": "ACLs created successfully",
+			"count":   len(acls),
+		})
+	}
+}
+
+// @Summary Delete ACLs
+// @Description Delete every ACL matching the given filters and return the deleted ACLs. The filter must set resourceName or principal, or a resourceType, patternType, operation or permission other than any or match, so that one call cannot delete every ACL.
+// @Tags acls
+// @Produce json
+// @Param resourceType query string false "topic, group, cluster, transactionalid or delegationtoken"
+// @Param resourceName query string false "Resource name"
+// @Param patternType query string false "literal, prefixed or match"
+// @Param principal query string false "Principal, e.g. User:alice"
+// @Param host query string false "Host"
+// @Param operation query string false "Operation, e.g. read, write, describe or all"
+// @Param permission query string false "allow or deny"
+// @Success 200 {object} ACLsResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v1/acls [delete]
+func DeleteACLs(client *kafka.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		deleted, err := client.DeleteACLs(aclFilterFromQuery(c))
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, ACLsResponse{ACLs: newACLResponses(deleted)})
+	}
+}
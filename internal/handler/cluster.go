@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"kafka-gateway/internal/kafka"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BrokerResponse struct {
+	ID         int32                 `json:"id" example:"1"`
+	Address    string                `json:"address" example:"kafka-1:9092"`
+	Rack       string                `json:"rack,omitempty" example:"eu-west-1a"`
+	Controller bool                  `json:"controller" example:"true"`
+	Configs    []ConfigEntryResponse `json:"configs,omitempty"`
+}
+
+type ClusterResponse struct {
+	ClusterID    string           `json:"clusterId" example:"lkc-8x2mq0TJQpGZ4l1i7zVZ5A"`
+	ControllerID int32            `json:"controllerId" example:"1"`
+	Brokers      []BrokerResponse `json:"brokers"`
+}
+
+type LogDirPartitionResponse struct {
+	Topic     string `json:"topic" example:"my-topic"`
+	Partition int32  `json:"partition" example:"0"`
+	Size      int64  `json:"size" example:"1048576"`
+	OffsetLag int64  `json:"offsetLag" example:"0"`
+	Temporary bool   `json:"temporary" example:"false"`
+}
+
+type LogDirResponse struct {
+	BrokerID       int32                     `json:"brokerId" example:"1"`
+	Path           string                    `json:"path" example:"/var/lib/kafka/data"`
+	Error          string                    `json:"error,omitempty"`
+	Size           int64                     `json:"size" example:"1048576"`
+	PartitionsSize int64                     `json:"partitionsSize" example:"524288"`
+	Partitions     []LogDirPartitionResponse `json:"partitions"`
+}
+
+type LogDirsResponse struct {
+	LogDirs []LogDirResponse `json:"logDirs"`
+}
+
+// @Summary Describe the cluster
+// @Description Get the cluster ID, the controller and the ID, address and rack of every broker. With configs=true each broker's configuration is included; sensitive values are omitted.
+// @Tags cluster
+// @Produce json
+// @Param configs query bool false "Include broker configs"
+// @Success 200 {object} ClusterResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v1/cluster [get]
+func DescribeCluster(client *kafka.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		includeConfigs := false
+		if v := c.Query("configs"); v != "" {
+			var err error
+			includeConfigs, err = strconv.ParseBool(v)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "configs must be true or false"})
+				return
+			}
+		}
+
+		cluster, err := client.DescribeCluster(includeConfigs)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		brokers := make([]BrokerResponse, len(cluster.Brokers))
+		for i, b := range cluster.Brokers {
+			brokers[i] = BrokerResponse{
+				ID:         b.ID,
+				Address:    b.Address,
+				Rack:       b.Rack,
+				Controller: b.Controller,
+			}
+			if includeConfigs {
+				brokers[i].Configs = newConfigEntryResponses(b.Configs)
+			}
+		}
+
+		c.JSON(http.StatusOK, ClusterResponse{
+			ClusterID:    cluster.ClusterID,
+			ControllerID: cluster.ControllerID,
+			Brokers:      brokers,
+		})
+	}
+}
+
+// @Summary Describe broker log directories
+// @Description Get the log directories of each broker with the on-disk size and offset lag of every partition replica they hold. size is the total of the directory, partitionsSize that of the listed partitions. Offline directories carry an error.
+// @Tags cluster
+// @Produce json
+// @Param broker query []int false "Broker IDs, all brokers if omitted" collectionFormat(multi)
+// @Param topic query string false "Only report partitions of this topic"
+// @Success 200 {object} LogDirsResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v1/cluster/log-dirs [get]
+func DescribeLogDirs(client *kafka.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var brokerIDs []int32
+		for _, v := range c.QueryArray("broker") {
+			id, err := strconv.ParseInt(v, 10, 32)
+			if err != nil || id < 0 {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "broker must be a non-negative integer"})
+				return
+			}
+			brokerIDs = append(brokerIDs, int32(id))
+		}
+
+		logDirs, err := client.DescribeLogDirs(brokerIDs, c.Query("topic"))
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		resp := LogDirsResponse{LogDirs: make([]LogDirResponse, len(logDirs))}
+		for i, d := range logDirs {
+			partitions := make([]LogDirPartitionResponse, len(d.Partitions))
+			for j, p := range d.Partitions {
+				partitions[j] = LogDirPartitionResponse{
+					Topic:     p.Topic,
+					Partition: p.Partition,
+					Size:      p.Size,
+					OffsetLag: p.OffsetLag,
+					Temporary: p.Temporary,
+				}
+			}
+			resp.LogDirs[i] = LogDirResponse{
+				BrokerID:       d.BrokerID,
+				Path:           d.Path,
+				Error:          d.Error,
+				Size:           d.Size,
+				PartitionsSize: d.PartitionsSize,
+				Partitions:     partitions,
+			}
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"errors"
+	"kafka-gateway/internal/kafka"
+	"kafka-gateway/internal/tenant"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// errorStatus maps an error from the Kafka client or tenancy checks to an HTTP status code.
+func errorStatus(err error) int {
+	switch {
+	case kafka.IsUnavailable(err):
+		return http.StatusServiceUnavailable
+	case errors.Is(err, tenant.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, tenant.ErrQuotaExceeded):
+		return http.StatusTooManyRequests
+	case errors.Is(err, kafka.ErrTopicNotFound),
+		errors.Is(err, kafka.ErrGroupNotFound),
+		errors.Is(err, kafka.ErrBrokerNotFound),
+		errors.Is(err, kafka.ErrClusterNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, kafka.ErrGroupNotEmpty),
+		errors.Is(err, kafka.ErrSecurityDisabled):
+		return http.StatusConflict
+	case errors.Is(err, kafka.ErrInvalidPartition),
+		errors.Is(err, kafka.ErrPartitionRequired),
+		errors.Is(err, kafka.ErrOffsetOutOfRange),
+		errors.Is(err, kafka.ErrInvalidConfig),
+		errors.Is(err, kafka.ErrInvalidAssignment),
+		errors.Is(err, kafka.ErrInvalidPartitionCount),
+		errors.Is(err, kafka.ErrInvalidOffsetReset),
+		errors.Is(err, kafka.ErrInvalidTruncation),
+		errors.Is(err, kafka.ErrInvalidACL):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// respondError writes err with the status code matching its cause.
+func respondError(c *gin.Context, err error) {
+	c.JSON(errorStatus(err), gin.H{"error": err.Error()})
+}
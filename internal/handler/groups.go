@@ -0,0 +1,311 @@
+package handler
+
+import (
+	"kafka-gateway/internal/kafka"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ConsumerGroupSummaryResponse struct {
+	GroupID      string `json:"groupId" example:"my-group"`
+	ProtocolType string `json:"protocolType" example:"consumer"`
+}
+
+type ConsumerGroupMemberResponse struct {
+	MemberID   string             `json:"memberId" example:"sarama-6f1c1a7e"`
+	ClientID   string             `json:"clientId" example:"sarama"`
+	ClientHost string             `json:"clientHost" example:"/10.0.0.12"`
+	Assignment map[string][]int32 `json:"assignment"`
+}
+
+type ConsumerGroupResponse struct {
+	GroupID      string                        `json:"groupId" example:"my-group"`
+	State        string                        `json:"state" example:"Stable"`
+	ProtocolType string                        `json:"protocolType" example:"consumer"`
+	Protocol     string                        `json:"protocol" example:"sticky"`
+	Members      []ConsumerGroupMemberResponse `json:"members"`
+}
+
+type PartitionLagResponse struct {
+	Topic           string `json:"topic" example:"my-topic"`
+	Partition       int32  `json:"partition" example:"0"`
+	CommittedOffset int64  `json:"committedOffset" example:"40"`
+	LatestOffset    int64  `json:"latestOffset" example:"42"`
+	Lag             int64  `json:"lag" example:"2"`
+}
+
+type ConsumerGroupOffsetsResponse struct {
+	GroupID    string                 `json:"groupId" example:"my-group"`
+	TotalLag   int64                  `json:"totalLag" example:"2"`
+	Partitions []PartitionLagResponse `json:"partitions"`
+}
+
+type ResetOffsetsRequest struct {
+	Topic      string          `json:"topic" binding:"required" example:"my-topic"`
+	Strategy   string          `json:"strategy" binding:"required,oneof=earliest latest timestamp shift explicit" example:"timestamp"`
+	Timestamp  *time.Time      `json:"timestamp,omitempty" binding:"required_if=Strategy timestamp"`
+	Shift      int64           `json:"shift,omitempty" binding:"required_if=Strategy shift" example:"-100"`
+	Offsets    map[int32]int64 `json:"offsets,omitempty" binding:"required_if=Strategy explicit"`
+	Partitions []int32         `json:"partitions,omitempty"`
+	DryRun     bool            `json:"dryRun,omitempty" example:"true"`
+}
+
+type PlannedOffsetResponse struct {
+	Partition     int32 `json:"partition" example:"0"`
+	CurrentOffset int64 `json:"currentOffset" example:"1200"`
+	NewOffset     int64 `json:"newOffset" example:"1100"`
+}
+
+type ResetOffsetsResponse struct {
+	Status     string                  `json:"status" example:"success"`
+	GroupID    string                  `json:"groupId" example:"my-group"`
+	Topic      string                  `json:"topic" example:"my-topic"`
+	Strategy   string                  `json:"strategy" example:"timestamp"`
+	DryRun     bool                    `json:"dryRun" example:"true"`
+	Partitions []PlannedOffsetResponse `json:"partitions"`
+}
+
+// @Summary List consumer groups
+// @Description Get every consumer group known to the cluster
+// @Tags consumer-groups
+// @Produce json
+// @Success 200 {object} map[string][]ConsumerGroupSummaryResponse
+// @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v1/consumer-groups [get]
+func ListConsumerGroups(client *kafka.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		groups, err := client.ListConsumerGroups()
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		t := tenantOf(c)
+		resp := make([]ConsumerGroupSummaryResponse, 0, len(groups))
+		for _, g := range groups {
+			if !t.Owns(g.GroupID) {
+				continue
+			}
+			resp = append(resp, ConsumerGroupSummaryResponse{
+				GroupID:      t.Local(g.GroupID),
+				ProtocolType: g.ProtocolType,
+			})
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"groups": resp,
+		})
+	}
+}
+
+// @Summary Describe a consumer group
+// @Description Get the state of a consumer group and the partitions assigned to each member
+// @Tags consumer-groups
+// @Produce json
+// @Param group path string true "Consumer group ID"
+// @Success 200 {object} ConsumerGroupResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v1/consumer-groups/{group} [get]
+func DescribeConsumerGroup(client *kafka.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		groupID := c.Param("group")
+		if groupID == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "group is required"})
+			return
+		}
+
+		t := tenantOf(c)
+		group, err := client.DescribeConsumerGroup(t.Qualify(groupID))
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		members := make([]ConsumerGroupMemberResponse, len(group.Members))
+		for i, m := range group.Members {
+			assignment := make(map[string][]int32, len(m.Assignment))
+			for topic, partitions := range m.Assignment {
+				// The group may also consume topics outside the tenant's namespace
+				if t.Owns(topic) {
+					assignment[t.Local(topic)] = partitions
+				}
+			}
+			members[i] = ConsumerGroupMemberResponse{
+				MemberID:   m.MemberID,
+				ClientID:   m.ClientID,
+				ClientHost: m.ClientHost,
+				Assignment: assignment,
+			}
+		}
+
+		c.JSON(http.StatusOK, ConsumerGroupResponse{
+			GroupID:      groupID,
+			State:        group.State,
+			ProtocolType: group.ProtocolType,
+			Protocol:     group.Protocol,
+			Members:      members,
+		})
+	}
+}
+
+// @Summary Get consumer group offsets and lag
+// @Description Get the committed offset, latest offset and lag of a consumer group for every partition it has committed on. Lag and latestOffset are -1 when the latest offset cannot be read.
+// @Tags consumer-groups
+// @Produce json
+// @Param group path string true "Consumer group ID"
+// @Param topic query string false "Only report partitions of this topic"
+// @Success 200 {object} ConsumerGroupOffsetsResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v1/consumer-groups/{group}/offsets [get]
+func GetConsumerGroupOffsets(client *kafka.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		groupID := c.Param("group")
+		if groupID == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "group is required"})
+			return
+		}
+
+		t := tenantOf(c)
+		topic := c.Query("topic")
+		if topic != "" {
+			topic = t.Qualify(topic)
+		}
+
+		lags, err := client.ConsumerGroupLag(t.Qualify(groupID), topic)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		resp := ConsumerGroupOffsetsResponse{
+			GroupID:    groupID,
+			Partitions: make([]PartitionLagResponse, 0, len(lags)),
+		}
+		for _, l := range lags {
+			// The group may also consume topics outside the tenant's namespace
+			if !t.Owns(l.Topic) {
+				continue
+			}
+			resp.Partitions = append(resp.Partitions, PartitionLagResponse{
+				Topic:           t.Local(l.Topic),
+				Partition:       l.Partition,
+				CommittedOffset: l.CommittedOffset,
+				LatestOffset:    l.LatestOffset,
+				Lag:             l.Lag,
+			})
+			if l.Lag > 0 {
+				resp.TotalLag += l.Lag
+			}
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Delete a consumer group
+// @Description Delete a consumer group and its committed offsets. Fails with 409 while the group has active members.
+// @Tags consumer-groups
+// @Produce json
+// @Param group path string true "Consumer group ID"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v1/consumer-groups/{group} [delete]
+func DeleteConsumerGroup(client *kafka.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		groupID := c.Param("group")
+		if groupID == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "group is required"})
+			return
+		}
+
+		if err := client.DeleteConsumerGroup(tenantOf(c).Qualify(groupID)); err != nil {
+			respondError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"status":  "success",
+			"message": "Consumer group deleted successfully",
+			"groupId": groupID,
+		})
+	}
+}
+
+// @Summary Reset consumer group offsets
+// @Description Move the committed offsets of a consumer group on a topic to the earliest or latest offset, the first offset at a timestamp, a shift from the current offset, or explicit per-partition offsets. Offsets are clamped to the retained range, except explicit ones which must fall inside it. Refused with 409 while the group has active members. With dryRun the planned offsets are returned without committing.
+// @Tags consumer-groups
+// @Accept json
+// @Produce json
+// @Param group path string true "Consumer group ID"
+// @Param request body ResetOffsetsRequest true "Reset strategy"
+// @Success 200 {object} ResetOffsetsResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v1/consumer-groups/{group}/offsets/reset [post]
+func ResetConsumerGroupOffsets(client *kafka.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		groupID := c.Param("group")
+		if groupID == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "group is required"})
+			return
+		}
+
+		var req ResetOffsetsRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		reset := kafka.OffsetReset{
+			Strategy:   req.Strategy,
+			Shift:      req.Shift,
+			Offsets:    req.Offsets,
+			Partitions: req.Partitions,
+			DryRun:     req.DryRun,
+		}
+		if req.Timestamp != nil {
+			reset.Timestamp = *req.Timestamp
+		}
+
+		t := tenantOf(c)
+		plan, err := client.ResetConsumerGroupOffsets(t.Qualify(groupID), t.Qualify(req.Topic), reset)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		resp := ResetOffsetsResponse{
+			Status:     "success",
+			GroupID:    groupID,
+			Topic:      req.Topic,
+			Strategy:   req.Strategy,
+			DryRun:     req.DryRun,
+			Partitions: make([]PlannedOffsetResponse, len(plan)),
+		}
+		for i, p := range plan {
+			resp.Partitions[i] = PlannedOffsetResponse{
+				Partition:     p.Partition,
+				CurrentOffset: p.CurrentOffset,
+				NewOffset:     p.NewOffset,
+			}
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
@@ -3,43 +3,237 @@ package handler
 import (
 	"io"
 	"kafka-gateway/internal/kafka"
+	"kafka-gateway/internal/middleware"
+	"kafka-gateway/internal/tenant"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
 type MessageRequest struct {
-	Key   string `json:"key,omitempty" example:"user-123"`
-	Value string `json:"value" binding:"required" example:"Hello, Kafka!"`
+	Key       string            `json:"key,omitempty" example:"user-123"`
+	Value     string            `json:"value" binding:"required" example:"Hello, Kafka!"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	Partition *int32            `json:"partition,omitempty" binding:"omitempty,min=0" example:"0"`
 }
 
+type BatchMessageRequest struct {
+	Messages []MessageRequest `json:"messages" binding:"required,min=1,max=1000,dive"`
+}
+
+type PublishResponse struct {
+	Status    string    `json:"status" example:"success"`
+	Message   string    `json:"message" example:"Message published successfully"`
+	Topic     string    `json:"topic" example:"my-topic"`
+	Partition int32     `json:"partition" example:"0"`
+	Offset    int64     `json:"offset" example:"42"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+type BatchResult struct {
+	Status    string     `json:"status" example:"success"`
+	Partition int32      `json:"partition" example:"0"`
+	Offset    int64      `json:"offset" example:"42"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+	Error     string     `json:"error,omitempty"`
+}
+
+type BatchResponse struct {
+	Status       string        `json:"status" example:"success"`
+	Topic        string        `json:"topic" example:"my-topic"`
+	Results      []BatchResult `json:"results"`
+	SuccessCount int           `json:"successCount" example:"2"`
+	FailureCount int           `json:"failureCount" example:"0"`
+}
+
+// CreateTopicRequest needs numPartitions and replicationFactor unless
+// replicaAssignment is given, in which case both are derived from it.
 type CreateTopicRequest struct {
-	NumPartitions     int32 `json:"numPartitions" binding:"required,min=1" example:"3"`
-	ReplicationFactor int16 `json:"replicationFactor" binding:"required,min=1" example:"1"`
+	NumPartitions     int32             `json:"numPartitions" binding:"required_without=ReplicaAssignment,gte=0" example:"3"`
+	ReplicationFactor int16             `json:"replicationFactor" binding:"required_without=ReplicaAssignment,gte=0" example:"1"`
+	ConfigEntries     map[string]string `json:"configEntries,omitempty"`
+	ReplicaAssignment [][]int32         `json:"replicaAssignment,omitempty"`
+	DryRun            bool              `json:"dryRun,omitempty" example:"false"`
 }
 
-// @Summary Health check endpoint
-// @Description Get the health status of the service
-// @Tags health
-// @Produce json
-// @Success 200 {object} map[string]string
-// @Router /health [get]
-func HealthCheck(c *gin.Context) {
-	c.JSON(http.StatusOK, gin.H{
-		"status": "healthy",
-	})
+type CreatePartitionsRequest struct {
+	Count             int32     `json:"count" binding:"required,min=1" example:"6"`
+	ReplicaAssignment [][]int32 `json:"replicaAssignment,omitempty"`
+	DryRun            bool      `json:"dryRun,omitempty" example:"false"`
+}
+
+type TruncateTopicRequest struct {
+	Offsets map[int32]int64 `json:"offsets,omitempty"`
+	Before  *time.Time      `json:"before,omitempty" example:"2024-05-01T09:00:00Z"`
+}
+
+type TruncatedPartitionResponse struct {
+	Partition              int32 `json:"partition" example:"0"`
+	PreviousEarliestOffset int64 `json:"previousEarliestOffset" example:"0"`
+	EarliestOffset         int64 `json:"earliestOffset" example:"1500"`
+}
+
+type TruncateTopicResponse struct {
+	Status     string                       `json:"status" example:"success"`
+	Message    string                       `json:"message" example:"Topic truncated successfully"`
+	Topic      string                       `json:"topic" example:"my-topic"`
+	Partitions []TruncatedPartitionResponse `json:"partitions"`
+}
+
+type TimestampOffsetResponse struct {
+	Partition int32 `json:"partition" example:"0"`
+	Offset    int64 `json:"offset" example:"1042"`
+	Latest    bool  `json:"latest" example:"false"`
+}
+
+type OffsetsForTimestampResponse struct {
+	Topic      string                    `json:"topic" example:"my-topic"`
+	Timestamp  time.Time                 `json:"timestamp" example:"2024-05-01T09:00:00Z"`
+	Partitions []TimestampOffsetResponse `json:"partitions"`
+}
+
+type CreatePartitionsResponse struct {
+	Status         string `json:"status" example:"success"`
+	Message        string `json:"message" example:"Partitions created successfully"`
+	Topic          string `json:"topic" example:"my-topic"`
+	PreviousCount  int32  `json:"previousCount" example:"3"`
+	PartitionCount int32  `json:"partitionCount" example:"6"`
+	DryRun         bool   `json:"dryRun" example:"false"`
+	Warning        string `json:"warning,omitempty"`
+}
+
+type PartitionDescriptionResponse struct {
+	Partition       int32   `json:"partition" example:"0"`
+	Leader          int32   `json:"leader" example:"1"`
+	Replicas        []int32 `json:"replicas"`
+	ISR             []int32 `json:"isr"`
+	OfflineReplicas []int32 `json:"offlineReplicas"`
+	EarliestOffset  int64   `json:"earliestOffset" example:"0"`
+	LatestOffset    int64   `json:"latestOffset" example:"100"`
+	UnderReplicated bool    `json:"underReplicated" example:"false"`
+}
+
+type TopicDescriptionResponse struct {
+	Topic                     string                         `json:"topic" example:"my-topic"`
+	Internal                  bool                           `json:"internal" example:"false"`
+	PartitionCount            int                            `json:"partitionCount" example:"3"`
+	UnderReplicatedPartitions int                            `json:"underReplicatedPartitions" example:"0"`
+	Partitions                []PartitionDescriptionResponse `json:"partitions"`
+}
+
+type ConfigEntryResponse struct {
+	Name      string `json:"name" example:"retention.ms"`
+	Value     string `json:"value" example:"604800000"`
+	Default   bool   `json:"default" example:"true"`
+	ReadOnly  bool   `json:"readOnly" example:"false"`
+	Sensitive bool   `json:"sensitive" example:"false"`
+	Source    string `json:"source" example:"Default"`
+}
+
+func newConfigEntryResponses(entries []kafka.ConfigEntry) []ConfigEntryResponse {
+	configs := make([]ConfigEntryResponse, len(entries))
+	for i, e := range entries {
+		configs[i] = ConfigEntryResponse{
+			Name:      e.Name,
+			Value:     e.Value,
+			Default:   e.Default,
+			ReadOnly:  e.ReadOnly,
+			Sensitive: e.Sensitive,
+			Source:    e.Source,
+		}
+	}
+	return configs
+}
+
+type TopicConfigResponse struct {
+	Topic   string                `json:"topic" example:"my-topic"`
+	Configs []ConfigEntryResponse `json:"configs"`
+}
+
+type ConfigAlterationRequest struct {
+	Name      string  `json:"name" binding:"required" example:"retention.ms"`
+	Value     *string `json:"value,omitempty" example:"86400000"`
+	Operation string  `json:"operation,omitempty" binding:"omitempty,oneof=set delete append subtract" example:"set"`
+}
+
+type AlterTopicConfigRequest struct {
+	Configs []ConfigAlterationRequest `json:"configs" binding:"required,min=1,dive"`
+}
+
+type MessageResponse struct {
+	Topic     string            `json:"topic" example:"my-topic"`
+	Partition int32             `json:"partition" example:"0"`
+	Offset    int64             `json:"offset" example:"42"`
+	Key       string            `json:"key,omitempty" example:"user-123"`
+	Value     string            `json:"value" example:"Hello, Kafka!"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	Timestamp time.Time         `json:"timestamp"`
+}
+
+type FetchMessagesResponse struct {
+	Topic         string            `json:"topic" example:"my-topic"`
+	Partition     int32             `json:"partition" example:"0"`
+	Messages      []MessageResponse `json:"messages"`
+	NextOffset    int64             `json:"nextOffset" example:"43"`
+	HighWatermark int64             `json:"highWatermark" example:"100"`
+}
+
+// recordHeaders merges the HTTP headers selected by middleware.ForwardHeaders
+// with headers from the request body. Body headers take precedence.
+func recordHeaders(c *gin.Context, headers map[string]string) map[string]string {
+	forwarded := c.GetStringMapString(middleware.RecordHeadersKey)
+	if len(forwarded) == 0 {
+		return headers
+	}
+
+	merged := make(map[string]string, len(forwarded)+len(headers))
+	for k, v := range forwarded {
+		merged[k] = v
+	}
+	for k, v := range headers {
+		merged[k] = v
+	}
+	return merged
+}
+
+func newKafkaMessage(c *gin.Context, req MessageRequest) *kafka.Message {
+	msg := &kafka.Message{
+		Value:           []byte(req.Value),
+		Headers:         recordHeaders(c, req.Headers),
+		TargetPartition: req.Partition,
+	}
+	if req.Key != "" {
+		msg.Key = []byte(req.Key)
+	}
+	return msg
+}
+
+// newMessageResponse converts a record, showing its topic as t sees it.
+func newMessageResponse(t *tenant.Tenant, msg *kafka.Message) MessageResponse {
+	return MessageResponse{
+		Topic:     t.Local(msg.Topic),
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Key:       string(msg.Key),
+		Value:     string(msg.Value),
+		Headers:   msg.Headers,
+		Timestamp: msg.Timestamp,
+	}
 }
 
 // @Summary Publish message to Kafka topic
-// @Description Publish a message to a specified Kafka topic
+// @Description Publish a message to a specified Kafka topic. The partition is chosen by the topic's configured partitioner unless given explicitly. Headers configured in server.forward_headers are copied from the HTTP request into record headers.
 // @Tags kafka
 // @Accept json
 // @Produce json
 // @Param topic path string true "Topic name"
 // @Param message body MessageRequest true "Message to publish"
-// @Success 200 {object} map[string]string
+// @Success 200 {object} PublishResponse
 // @Failure 400 {object} map[string]string
 // @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
 // @Router /api/v1/publish/{topic} [post]
 func PublishMessage(client *kafka.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -59,22 +253,96 @@ func PublishMessage(client *kafka.Client) gin.HandlerFunc {
 			return
 		}
 
-		var key []byte
-		if msg.Key != "" {
-			key = []byte(msg.Key)
+		result, err := client.PublishMessage(tenantOf(c).Qualify(topic), newKafkaMessage(c, msg))
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, PublishResponse{
+			Status:    "success",
+			Message:   "Message published successfully",
+			Topic:     topic,
+			Partition: result.Partition,
+			Offset:    result.Offset,
+			Timestamp: result.Timestamp,
+		})
+	}
+}
+
+// @Summary Publish a batch of messages to Kafka topic
+// @Description Publish up to 1000 messages in one request. Returns 200 when every message was written and 207 with per-message results otherwise.
+// @Tags kafka
+// @Accept json
+// @Produce json
+// @Param topic path string true "Topic name"
+// @Param batch body BatchMessageRequest true "Messages to publish"
+// @Success 200 {object} BatchResponse
+// @Success 207 {object} BatchResponse
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v1/publish/{topic}/batch [post]
+func PublishBatch(client *kafka.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		topic := c.Param("topic")
+		if topic == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "topic is required"})
+			return
 		}
 
-		err := client.PublishMessage(topic, key, []byte(msg.Value))
+		var req BatchMessageRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			if err == io.EOF {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "request body is required"})
+				return
+			}
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		messages := make([]*kafka.Message, len(req.Messages))
+		for i, msg := range req.Messages {
+			messages[i] = newKafkaMessage(c, msg)
+		}
+
+		results, err := client.PublishBatch(tenantOf(c).Qualify(topic), messages)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			respondError(c, err)
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{
-			"status":  "success",
-			"message": "Message published successfully",
-			"topic":   topic,
-		})
+		resp := BatchResponse{
+			Topic:   topic,
+			Results: make([]BatchResult, len(results)),
+		}
+		for i, r := range results {
+			if r.Err != nil {
+				resp.Results[i] = BatchResult{Status: "error", Error: r.Err.Error()}
+				resp.FailureCount++
+				continue
+			}
+			timestamp := r.Timestamp
+			resp.Results[i] = BatchResult{
+				Status:    "success",
+				Partition: r.Partition,
+				Offset:    r.Offset,
+				Timestamp: &timestamp,
+			}
+			resp.SuccessCount++
+		}
+
+		code := http.StatusOK
+		resp.Status = "success"
+		if resp.FailureCount > 0 {
+			code = http.StatusMultiStatus
+			resp.Status = "partial"
+			if resp.SuccessCount == 0 {
+				resp.Status = "failed"
+			}
+		}
+
+		c.JSON(code, resp)
 	}
 }
 
@@ -84,17 +352,18 @@ func PublishMessage(client *kafka.Client) gin.HandlerFunc {
 // @Produce json
 // @Success 200 {object} map[string][]string
 // @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
 // @Router /api/v1/topics [get]
 func ListTopics(client *kafka.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		topics, err := client.ListTopics()
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			respondError(c, err)
 			return
 		}
 
 		c.JSON(http.StatusOK, gin.H{
-			"topics": topics,
+			"topics": tenantOf(c).LocalNames(topics),
 		})
 	}
 }
@@ -106,7 +375,9 @@ func ListTopics(client *kafka.Client) gin.HandlerFunc {
 // @Param topic path string true "Topic name"
 // @Success 200 {object} map[string]interface{}
 // @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
 // @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
 // @Router /api/v1/topics/{topic}/partitions [get]
 func GetTopicPartitions(client *kafka.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -116,9 +387,9 @@ func GetTopicPartitions(client *kafka.Client) gin.HandlerFunc {
 			return
 		}
 
-		partitions, err := client.GetTopicPartitions(topic)
+		partitions, err := client.GetTopicPartitions(tenantOf(c).Qualify(topic))
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			respondError(c, err)
 			return
 		}
 
@@ -129,16 +400,229 @@ func GetTopicPartitions(client *kafka.Client) gin.HandlerFunc {
 	}
 }
 
+// @Summary Look up offsets by timestamp
+// @Description Get, for every partition, the earliest offset whose record timestamp is at or after the given time: the place to start a replay. When every record of a partition is older, the latest offset is returned and latest is true.
+// @Tags kafka
+// @Produce json
+// @Param topic path string true "Topic name"
+// @Param timestamp query string true "RFC 3339 time or milliseconds since the epoch"
+// @Success 200 {object} OffsetsForTimestampResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v1/topics/{topic}/offsets [get]
+func GetOffsetsForTimestamp(client *kafka.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		topic := c.Param("topic")
+		if topic == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "topic is required"})
+			return
+		}
+
+		timestamp, err := parseTimestamp(c.Query("timestamp"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "timestamp must be an RFC 3339 time or milliseconds since the epoch"})
+			return
+		}
+
+		offsets, err := client.OffsetsForTimestamp(tenantOf(c).Qualify(topic), timestamp)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		resp := OffsetsForTimestampResponse{
+			Topic:      topic,
+			Timestamp:  timestamp,
+			Partitions: make([]TimestampOffsetResponse, len(offsets)),
+		}
+		for i, o := range offsets {
+			resp.Partitions[i] = TimestampOffsetResponse{
+				Partition: o.Partition,
+				Offset:    o.Offset,
+				Latest:    o.Latest,
+			}
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// parseTimestamp accepts an RFC 3339 time or milliseconds since the epoch.
+func parseTimestamp(v string) (time.Time, error) {
+	if ms, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.UnixMilli(ms).UTC(), nil
+	}
+	return time.Parse(time.RFC3339Nano, v)
+}
+
+// @Summary Describe a Kafka topic
+// @Description Get the leader, replicas, in-sync replicas, offline replicas and earliest/latest offsets of every partition. Partitions whose ISR is smaller than their replica set are flagged as under-replicated. Offsets are -1 when a partition cannot be read.
+// @Tags kafka
+// @Produce json
+// @Param topic path string true "Topic name"
+// @Success 200 {object} TopicDescriptionResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v1/topics/{topic} [get]
+func DescribeTopic(client *kafka.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		topic := c.Param("topic")
+		if topic == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "topic is required"})
+			return
+		}
+
+		desc, err := client.DescribeTopic(tenantOf(c).Qualify(topic))
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		partitions := make([]PartitionDescriptionResponse, len(desc.Partitions))
+		for i, p := range desc.Partitions {
+			partitions[i] = PartitionDescriptionResponse{
+				Partition:       p.ID,
+				Leader:          p.Leader,
+				Replicas:        append([]int32{}, p.Replicas...),
+				ISR:             append([]int32{}, p.ISR...),
+				OfflineReplicas: append([]int32{}, p.OfflineReplicas...),
+				EarliestOffset:  p.EarliestOffset,
+				LatestOffset:    p.LatestOffset,
+				UnderReplicated: p.UnderReplicated,
+			}
+		}
+
+		c.JSON(http.StatusOK, TopicDescriptionResponse{
+			Topic:                     tenantOf(c).Local(desc.Name),
+			Internal:                  desc.Internal,
+			PartitionCount:            len(partitions),
+			UnderReplicatedPartitions: desc.UnderReplicatedPartitions,
+			Partitions:                partitions,
+		})
+	}
+}
+
+// @Summary Increase topic partitions
+// @Description Grow a topic to a new partition count, optionally assigning brokers to each new partition. The response carries a warning when the topic holds keyed records, since key-to-partition mapping changes.
+// @Tags kafka
+// @Accept json
+// @Produce json
+// @Param topic path string true "Topic name"
+// @Param request body CreatePartitionsRequest true "New partition count"
+// @Success 200 {object} CreatePartitionsResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v1/topics/{topic}/partitions [post]
+func CreatePartitions(client *kafka.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		topic := c.Param("topic")
+		if topic == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "topic is required"})
+			return
+		}
+
+		var req CreatePartitionsRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		result, err := client.CreatePartitions(tenantOf(c).Qualify(topic), req.Count, req.ReplicaAssignment, req.DryRun)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		resp := CreatePartitionsResponse{
+			Status:         "success",
+			Message:        "Partitions created successfully",
+			Topic:          topic,
+			PreviousCount:  result.PreviousCount,
+			PartitionCount: result.Count,
+			DryRun:         req.DryRun,
+		}
+		if req.DryRun {
+			resp.Message = "Partition increase validated successfully"
+		}
+		if result.KeyedData {
+			resp.Warning = kafka.KeyedDataWarning
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// @Summary Truncate a topic
+// @Description Delete the records of a topic before per-partition offsets, or before a timestamp on every partition. An offset of -1 deletes every record of the partition. Consumers keep working and resume from the new earliest offset.
+// @Tags kafka
+// @Accept json
+// @Produce json
+// @Param topic path string true "Topic name"
+// @Param request body TruncateTopicRequest true "Offsets or timestamp to truncate before"
+// @Success 200 {object} TruncateTopicResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v1/topics/{topic}/truncate [post]
+func TruncateTopic(client *kafka.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		topic := c.Param("topic")
+		if topic == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "topic is required"})
+			return
+		}
+
+		var req TruncateTopicRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var before time.Time
+		if req.Before != nil {
+			before = *req.Before
+		}
+
+		truncated, err := client.TruncateTopic(tenantOf(c).Qualify(topic), req.Offsets, before)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		resp := TruncateTopicResponse{
+			Status:     "success",
+			Message:    "Topic truncated successfully",
+			Topic:      topic,
+			Partitions: make([]TruncatedPartitionResponse, len(truncated)),
+		}
+		for i, t := range truncated {
+			resp.Partitions[i] = TruncatedPartitionResponse{
+				Partition:              t.Partition,
+				PreviousEarliestOffset: t.PreviousEarliestOffset,
+				EarliestOffset:         t.EarliestOffset,
+			}
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
 // @Summary Create a new Kafka topic
-// @Description Create a new topic with specified partitions and replication factor
+// @Description Create a new topic with specified partitions and replication factor, optional config entries (e.g. cleanup.policy, retention.ms) and an optional manual replica assignment where entry i lists the brokers for partition i. With dryRun the request is validated by the controller without creating the topic.
 // @Tags kafka
 // @Accept json
 // @Produce json
 // @Param topic path string true "Topic name"
 // @Param request body CreateTopicRequest true "Topic configuration"
-// @Success 201 {object} map[string]string
+// @Success 200 {object} map[string]interface{}
+// @Success 201 {object} map[string]interface{}
 // @Failure 400 {object} map[string]string
 // @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
 // @Router /api/v1/topics/{topic} [post]
 func CreateTopic(client *kafka.Client) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -154,9 +638,25 @@ func CreateTopic(client *kafka.Client) gin.HandlerFunc {
 			return
 		}
 
-		err := client.CreateTopic(topic, req.NumPartitions, req.ReplicationFactor)
+		err := client.CreateTopic(tenantOf(c).Qualify(topic), kafka.TopicSpec{
+			NumPartitions:     req.NumPartitions,
+			ReplicationFactor: req.ReplicationFactor,
+			ConfigEntries:     req.ConfigEntries,
+			ReplicaAssignment: req.ReplicaAssignment,
+			ValidateOnly:      req.DryRun,
+		})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			respondError(c, err)
+			return
+		}
+
+		if req.DryRun {
+			c.JSON(http.StatusOK, gin.H{
+				"status":  "success",
+				"message": "Topic creation validated successfully",
+				"topic":   topic,
+				"dryRun":  true,
+			})
 			return
 		}
 
@@ -164,6 +664,186 @@ func CreateTopic(client *kafka.Client) gin.HandlerFunc {
 			"status":  "success",
 			"message": "Topic created successfully",
 			"topic":   topic,
+			"dryRun":  false,
+		})
+	}
+}
+
+// @Summary Fetch messages from a topic partition
+// @Description Read messages from a partition starting at the given offset (earliest if omitted)
+// @Tags kafka
+// @Produce json
+// @Param topic path string true "Topic name"
+// @Param partition path int true "Partition ID"
+// @Param offset query int false "Offset to start reading from"
+// @Param limit query int false "Maximum number of messages to return (default 100, max 1000)"
+// @Success 200 {object} FetchMessagesResponse
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v1/topics/{topic}/partitions/{partition}/messages [get]
+func FetchMessages(client *kafka.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		topic := c.Param("topic")
+		if topic == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "topic is required"})
+			return
+		}
+
+		partition, err := strconv.ParseInt(c.Param("partition"), 10, 32)
+		if err != nil || partition < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "partition must be a non-negative integer"})
+			return
+		}
+
+		offset := int64(-1)
+		if v := c.Query("offset"); v != "" {
+			offset, err = strconv.ParseInt(v, 10, 64)
+			if err != nil || offset < 0 {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "offset must be a non-negative integer"})
+				return
+			}
+		}
+
+		limit := kafka.DefaultFetchLimit
+		if v := c.Query("limit"); v != "" {
+			limit, err = strconv.Atoi(v)
+			if err != nil || limit < 1 || limit > kafka.MaxFetchLimit {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 1000"})
+				return
+			}
+		}
+
+		result, err := client.FetchMessages(tenantOf(c).Qualify(topic), int32(partition), offset, limit)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		messages := make([]MessageResponse, len(result.Messages))
+		for i, msg := range result.Messages {
+			messages[i] = newMessageResponse(tenantOf(c), msg)
+		}
+
+		c.JSON(http.StatusOK, FetchMessagesResponse{
+			Topic:         topic,
+			Partition:     int32(partition),
+			Messages:      messages,
+			NextOffset:    result.NextOffset,
+			HighWatermark: result.HighWatermark,
+		})
+	}
+}
+
+// @Summary Delete a Kafka topic
+// @Description Delete a topic and all of its data
+// @Tags kafka
+// @Produce json
+// @Param topic path string true "Topic name"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v1/topics/{topic} [delete]
+func DeleteTopic(client *kafka.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		topic := c.Param("topic")
+		if topic == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "topic is required"})
+			return
+		}
+
+		if err := client.DeleteTopic(tenantOf(c).Qualify(topic)); err != nil {
+			respondError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"status":  "success",
+			"message": "Topic deleted successfully",
+			"topic":   topic,
+		})
+	}
+}
+
+// @Summary Describe topic configuration
+// @Description Get every configuration entry of a topic, including defaults. Sensitive values are omitted.
+// @Tags kafka
+// @Produce json
+// @Param topic path string true "Topic name"
+// @Success 200 {object} TopicConfigResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v1/topics/{topic}/config [get]
+func DescribeTopicConfig(client *kafka.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		topic := c.Param("topic")
+		if topic == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "topic is required"})
+			return
+		}
+
+		entries, err := client.DescribeTopicConfig(tenantOf(c).Qualify(topic))
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, TopicConfigResponse{
+			Topic:   topic,
+			Configs: newConfigEntryResponses(entries),
+		})
+	}
+}
+
+// @Summary Alter topic configuration
+// @Description Incrementally alter topic configuration. Each entry sets, deletes (reverts to default), appends to or subtracts from one key; keys not listed are left unchanged.
+// @Tags kafka
+// @Accept json
+// @Produce json
+// @Param topic path string true "Topic name"
+// @Param request body AlterTopicConfigRequest true "Config alterations"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v1/topics/{topic}/config [patch]
+func AlterTopicConfig(client *kafka.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		topic := c.Param("topic")
+		if topic == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "topic is required"})
+			return
+		}
+
+		var req AlterTopicConfigRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		alterations := make([]kafka.ConfigAlteration, len(req.Configs))
+		for i, a := range req.Configs {
+			alterations[i] = kafka.ConfigAlteration{
+				Name:      a.Name,
+				Value:     a.Value,
+				Operation: a.Operation,
+			}
+		}
+
+		if err := client.AlterTopicConfig(tenantOf(c).Qualify(topic), alterations); err != nil {
+			respondError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"status":  "success",
+			"message": "Topic config altered successfully",
+			"topic":   topic,
 		})
 	}
 }
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"kafka-gateway/internal/kafka"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HealthResponse struct {
+	Status       string     `json:"status" example:"healthy"`
+	ControllerID *int32     `json:"controllerId,omitempty" example:"1"`
+	BrokerCount  *int       `json:"brokerCount,omitempty" example:"3"`
+	LastProbe    *time.Time `json:"lastProbe,omitempty"`
+	Error        string     `json:"error,omitempty"`
+	// Clusters breaks readiness down by cluster, including the default one
+	Clusters map[string]ClusterHealthResponse `json:"clusters,omitempty"`
+}
+
+type ClusterHealthResponse struct {
+	Status       string     `json:"status" example:"healthy"`
+	ControllerID *int32     `json:"controllerId,omitempty" example:"1"`
+	BrokerCount  *int       `json:"brokerCount,omitempty" example:"3"`
+	LastProbe    *time.Time `json:"lastProbe,omitempty"`
+	Error        string     `json:"error,omitempty"`
+}
+
+// newClusterHealthResponse reports the broker probe of one cluster.
+func newClusterHealthResponse(health *kafka.ClusterHealth, err error) ClusterHealthResponse {
+	resp := ClusterHealthResponse{Status: "healthy"}
+	if !health.LastSuccess.IsZero() {
+		resp.LastProbe = &health.LastSuccess
+	}
+	if err != nil {
+		resp.Status = "unhealthy"
+		resp.Error = err.Error()
+		return resp
+	}
+
+	resp.ControllerID = &health.ControllerID
+	resp.BrokerCount = &health.BrokerCount
+	return resp
+}
+
+// @Summary Liveness probe
+// @Description Reports that the gateway process is running. It does not check Kafka.
+// @Tags health
+// @Produce json
+// @Success 200 {object} HealthResponse
+// @Router /health/live [get]
+func Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, HealthResponse{Status: "healthy"})
+}
+
+// @Summary Startup probe
+// @Description Succeeds once the gateway has made its initial connection to Kafka.
+// @Tags health
+// @Produce json
+// @Success 200 {object} HealthResponse
+// @Failure 503 {object} HealthResponse
+// @Router /health/startup [get]
+func Startup(client *kafka.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !client.Connected() {
+			c.JSON(http.StatusServiceUnavailable, HealthResponse{
+				Status: "starting",
+				Error:  kafka.ErrUnavailable.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusOK, HealthResponse{Status: "healthy"})
+	}
+}
+
+// @Summary Readiness probe
+// @Description Checks broker connectivity of every cluster by fetching cluster metadata. The status code and top-level fields follow the default cluster; clusters lists each cluster's controller, broker count and time of the last successful probe. The status is degraded while the default cluster is healthy and another is not. Clusters are probed concurrently, and one that does not answer within 800ms counts as unhealthy.
+// @Tags health
+// @Produce json
+// @Success 200 {object} HealthResponse
+// @Failure 503 {object} HealthResponse
+// @Router /health [get]
+// @Router /health/ready [get]
+func Readiness(registry *kafka.Registry) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		clusters := make(map[string]ClusterHealthResponse)
+		degraded := false
+		for name, result := range registry.ProbeAll(kafka.ProbeTimeout) {
+			clusters[name] = newClusterHealthResponse(result.Health, result.Err)
+			if result.Err != nil {
+				degraded = true
+			}
+		}
+
+		def := clusters[registry.DefaultName()]
+		resp := HealthResponse{
+			Status:       def.Status,
+			ControllerID: def.ControllerID,
+			BrokerCount:  def.BrokerCount,
+			LastProbe:    def.LastProbe,
+			Error:        def.Error,
+			Clusters:     clusters,
+		}
+		if def.Error != "" {
+			c.JSON(http.StatusServiceUnavailable, resp)
+			return
+		}
+		if degraded {
+			resp.Status = "degraded"
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
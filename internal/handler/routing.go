@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"kafka-gateway/internal/kafka"
+	"kafka-gateway/internal/tenant"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ClusterHeader selects the Kafka cluster on routes without a cluster path segment.
+const ClusterHeader = "X-Kafka-Cluster"
+
+// PerCluster builds h for every cluster in registry and dispatches each
+// request to the cluster named by the :cluster path parameter, else the
+// X-Kafka-Cluster header, else the default cluster.
+func PerCluster(registry *kafka.Registry, h func(*kafka.Client) gin.HandlerFunc) gin.HandlerFunc {
+	handlers := make(map[string]gin.HandlerFunc)
+	for _, name := range registry.Names() {
+		client, _ := registry.Client(name)
+		handlers[name] = h(client)
+	}
+
+	return func(c *gin.Context) {
+		name := c.Param("cluster")
+		if name == "" {
+			name = c.GetHeader(ClusterHeader)
+		}
+		if name == "" {
+			name = registry.DefaultName()
+		}
+
+		handler, ok := handlers[name]
+		if !ok {
+			_, err := registry.Client(name)
+			respondError(c, err)
+			return
+		}
+		handler(c)
+	}
+}
+
+// tenantOf returns the tenant of the request, or nil for callers outside any
+// tenant, whose topic names pass through unchanged.
+func tenantOf(c *gin.Context) *tenant.Tenant {
+	return tenant.FromContext(c.Request.Context())
+}
+
+// OperatorOnly rejects tenants on routes that act on the whole cluster and
+// cannot be confined to a namespace.
+func OperatorOnly(operation string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := tenantOf(c).Forbid(operation); err != nil {
+			respondError(c, err)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+type ClustersResponse struct {
+	Clusters []string `json:"clusters" example:"analytics,default,dr"`
+	Default  string   `json:"default" example:"default"`
+}
+
+// @Summary List Kafka clusters
+// @Description Get the names of the configured Kafka clusters. Select one with the /api/v1/clusters/{cluster}/ path prefix or the X-Kafka-Cluster header; requests without either use the default cluster.
+// @Tags cluster
+// @Produce json
+// @Success 200 {object} ClustersResponse
+// @Router /api/v1/clusters [get]
+func ListClusters(registry *kafka.Registry) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, ClustersResponse{
+			Clusters: registry.Names(),
+			Default:  registry.DefaultName(),
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"kafka-gateway/internal/kafka"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+)
+
+const streamKeepAliveInterval = 15 * time.Second
+
+// parseStreamCursor decodes a Last-Event-ID of the form "partition:offset,..."
+// into the next offset to read for each partition.
+func parseStreamCursor(id string) (map[int32]int64, error) {
+	offsets := make(map[int32]int64)
+	if id == "" {
+		return offsets, nil
+	}
+
+	for _, entry := range strings.Split(id, ",") {
+		parts := strings.SplitN(entry, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid event id entry %q", entry)
+		}
+		partition, err := strconv.ParseInt(parts[0], 10, 32)
+		if err != nil || partition < 0 {
+			return nil, fmt.Errorf("invalid partition in event id entry %q", entry)
+		}
+		offset, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil || offset < 0 {
+			return nil, fmt.Errorf("invalid offset in event id entry %q", entry)
+		}
+		offsets[int32(partition)] = offset
+	}
+	return offsets, nil
+}
+
+// formatStreamCursor encodes the next offset for each partition as an event id.
+func formatStreamCursor(offsets map[int32]int64) string {
+	partitions := make([]int32, 0, len(offsets))
+	for p := range offsets {
+		partitions = append(partitions, p)
+	}
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i] < partitions[j] })
+
+	entries := make([]string, len(partitions))
+	for i, p := range partitions {
+		entries[i] = fmt.Sprintf("%d:%d", p, offsets[p])
+	}
+	return strings.Join(entries, ",")
+}
+
+// @Summary Stream topic messages
+// @Description Tail a topic as Server-Sent Events. Each event id encodes the next offset per partition; send it back as Last-Event-ID to resume.
+// @Tags kafka
+// @Produce text/event-stream
+// @Param topic path string true "Topic name"
+// @Param Last-Event-ID header string false "Resume cursor from a previous event, e.g. 0:42,1:17"
+// @Success 200 {object} MessageResponse
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v1/topics/{topic}/stream [get]
+func StreamMessages(client *kafka.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		topic := c.Param("topic")
+		if topic == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "topic is required"})
+			return
+		}
+
+		cursor, err := parseStreamCursor(c.GetHeader("Last-Event-ID"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		// Start from the resolved offset of every partition so that each
+		// event id resumes all of them, not only those that had records
+		messages, cursor, err := client.Tail(c.Request.Context(), tenantOf(c).Qualify(topic), cursor)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		c.Header("Cache-Control", "no-cache")
+		c.Header("Connection", "keep-alive")
+		c.Header("X-Accel-Buffering", "no")
+
+		keepAlive := time.NewTicker(streamKeepAliveInterval)
+		defer keepAlive.Stop()
+
+		c.Stream(func(w io.Writer) bool {
+			select {
+			case msg, ok := <-messages:
+				if !ok {
+					return false
+				}
+				data, err := json.Marshal(newMessageResponse(tenantOf(c), msg))
+				if err != nil {
+					return false
+				}
+				cursor[msg.Partition] = msg.Offset + 1
+				c.Render(-1, sse.Event{
+					Id:    formatStreamCursor(cursor),
+					Event: "message",
+					Data:  string(data),
+				})
+				return true
+			case <-keepAlive.C:
+				_, err := io.WriteString(w, ": keep-alive\n\n")
+				return err == nil
+			}
+		})
+	}
+}
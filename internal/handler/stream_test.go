@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseStreamCursor(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		want    map[int32]int64
+		wantErr bool
+	}{
+		{name: "empty", id: "", want: map[int32]int64{}},
+		{name: "single partition", id: "0:42", want: map[int32]int64{0: 42}},
+		{name: "several partitions", id: "0:42,1:17,5:0", want: map[int32]int64{0: 42, 1: 17, 5: 0}},
+		{name: "missing offset", id: "0", wantErr: true},
+		{name: "empty entry", id: "0:42,", wantErr: true},
+		{name: "negative partition", id: "-1:42", wantErr: true},
+		{name: "negative offset", id: "0:-2", wantErr: true},
+		{name: "partition out of range", id: "2147483648:0", wantErr: true},
+		{name: "not a number", id: "a:b", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseStreamCursor(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseStreamCursor(%q) error = %v, wantErr %v", tt.id, err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("parseStreamCursor(%q) = %v, want %v", tt.id, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatStreamCursor(t *testing.T) {
+	tests := []struct {
+		name    string
+		offsets map[int32]int64
+		want    string
+	}{
+		{name: "empty", offsets: map[int32]int64{}, want: ""},
+		{name: "sorted by partition", offsets: map[int32]int64{10: 3, 2: 7, 0: 42}, want: "0:42,2:7,10:3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := formatStreamCursor(tt.offsets)
+			if got != tt.want {
+				t.Fatalf("formatStreamCursor() = %q, want %q", got, tt.want)
+			}
+			parsed, err := parseStreamCursor(got)
+			if err != nil {
+				t.Fatalf("parseStreamCursor(%q) error = %v", got, err)
+			}
+			if !reflect.DeepEqual(parsed, tt.offsets) {
+				t.Fatalf("round trip = %v, want %v", parsed, tt.offsets)
+			}
+		})
+	}
+}
@@ -0,0 +1,387 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"kafka-gateway/internal/kafka"
+	"kafka-gateway/internal/tenant"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	wsReadLimit    = 1 << 20
+	wsPongWait     = 60 * time.Second
+	wsPingInterval = 30 * time.Second
+	wsWriteWait    = 10 * time.Second
+)
+
+// Frame types exchanged over the WebSocket channel. Clients send subscribe,
+// unsubscribe, publish and ack frames; the gateway replies to each with an ack
+// or error frame carrying the same id, and delivers records as message frames.
+// A subscribe frame with a groupId joins that consumer group, and the client
+// acks each record it has processed to commit its offset. Without a groupId,
+// the subscription tails the topic from the offsets in the frame, which are
+// the offsets of the next records to read: last processed offset + 1.
+const (
+	frameSubscribe   = "subscribe"
+	frameUnsubscribe = "unsubscribe"
+	framePublish     = "publish"
+	frameAck         = "ack"
+	frameMessage     = "message"
+	frameError       = "error"
+)
+
+type WebSocketRequest struct {
+	Type          string            `json:"type" example:"publish"`
+	ID            string            `json:"id,omitempty" example:"1"`
+	Topic         string            `json:"topic" example:"my-topic"`
+	Key           string            `json:"key,omitempty" example:"user-123"`
+	Value         string            `json:"value,omitempty" example:"Hello, Kafka!"`
+	Headers       map[string]string `json:"headers,omitempty"`
+	Partition     *int32            `json:"partition,omitempty" example:"0"`
+	Offset        *int64            `json:"offset,omitempty" example:"42"`
+	Offsets       map[int32]int64   `json:"offsets,omitempty"`
+	GroupID       string            `json:"groupId,omitempty" example:"my-group"`
+	FromBeginning bool              `json:"fromBeginning,omitempty"`
+}
+
+type WebSocketResponse struct {
+	Type      string           `json:"type" example:"ack"`
+	ID        string           `json:"id,omitempty" example:"1"`
+	Topic     string           `json:"topic,omitempty" example:"my-topic"`
+	Partition *int32           `json:"partition,omitempty" example:"0"`
+	Offset    *int64           `json:"offset,omitempty" example:"42"`
+	Message   *MessageResponse `json:"message,omitempty"`
+	Error     string           `json:"error,omitempty"`
+}
+
+// newOriginChecker guards against cross-site WebSocket hijacking. Browsers
+// attach client certificates to cross-site handshakes and CORS does not apply
+// to them, so only the gateway's own origin and allowedOrigins are accepted.
+// Handshakes without an Origin header come from non-browser clients.
+func newOriginChecker(allowedOrigins []string) func(r *http.Request) bool {
+	allowed := make(map[string]bool, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		allowed[strings.ToLower(strings.TrimSuffix(origin, "/"))] = true
+	}
+
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+		u, err := url.Parse(origin)
+		if err != nil {
+			return false
+		}
+		return strings.EqualFold(u.Host, r.Host) || allowed[strings.ToLower(origin)]
+	}
+}
+
+// wsSession tracks the topic subscriptions of a single WebSocket connection.
+type wsSession struct {
+	client *kafka.Client
+	tenant *tenant.Tenant
+	conn   *websocket.Conn
+	ctx    context.Context
+	gin    *gin.Context
+	out    chan WebSocketResponse
+
+	mu            sync.Mutex
+	subscriptions map[string]*wsSubscription
+	wg            sync.WaitGroup
+}
+
+// wsSubscription is one subscribed topic. group is set for subscriptions in
+// a consumer group, which take acks.
+type wsSubscription struct {
+	cancel context.CancelFunc
+	group  *kafka.Subscription
+}
+
+// @Summary WebSocket produce/consume channel
+// @Description Upgrade to a WebSocket that accepts JSON frames of type subscribe, unsubscribe, publish and ack. Each frame is answered with an ack or error frame carrying its id, and subscribed records arrive as message frames. Subscriptions with a groupId join the consumer group, and client ack frames commit the offsets of processed records. Browser handshakes are refused with 403 unless they come from the gateway's own origin or one listed in server.websocket_origins.
+// @Tags kafka
+// @Success 101 {object} WebSocketResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Router /api/v1/ws [get]
+func WebSocket(allowedOrigins []string) func(*kafka.Client) gin.HandlerFunc {
+	upgrader := websocket.Upgrader{
+		ReadBufferSize:  4096,
+		WriteBufferSize: 4096,
+		CheckOrigin:     newOriginChecker(allowedOrigins),
+	}
+
+	return func(client *kafka.Client) gin.HandlerFunc {
+		return func(c *gin.Context) {
+			conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+			if err != nil {
+				// Upgrade has already written an HTTP error response
+				return
+			}
+			defer conn.Close()
+
+			ctx, cancel := context.WithCancel(c.Request.Context())
+			defer cancel()
+
+			s := &wsSession{
+				client:        client,
+				tenant:        tenantOf(c),
+				conn:          conn,
+				ctx:           ctx,
+				gin:           c,
+				out:           make(chan WebSocketResponse, 64),
+				subscriptions: make(map[string]*wsSubscription),
+			}
+
+			go s.writeLoop(cancel)
+			s.readLoop()
+
+			cancel()
+			s.wg.Wait()
+		}
+	}
+}
+
+func (s *wsSession) readLoop() {
+	s.conn.SetReadLimit(wsReadLimit)
+	s.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	s.conn.SetPongHandler(func(string) error {
+		return s.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+
+	for {
+		var req WebSocketRequest
+		if err := s.conn.ReadJSON(&req); err != nil {
+			var syntaxErr *json.SyntaxError
+			var typeErr *json.UnmarshalTypeError
+			if !errors.As(err, &syntaxErr) && !errors.As(err, &typeErr) {
+				return
+			}
+			// Malformed frame: report it and keep the connection open
+			if !s.send(WebSocketResponse{Type: frameError, Error: "invalid frame: " + err.Error()}) {
+				return
+			}
+			continue
+		}
+
+		if req.Topic == "" {
+			s.send(WebSocketResponse{Type: frameError, ID: req.ID, Error: "topic is required"})
+			continue
+		}
+
+		// Every frame counts against the tenant's quota, like a request
+		if err := s.tenant.Allow(); err != nil {
+			s.send(WebSocketResponse{Type: frameError, ID: req.ID, Topic: req.Topic, Error: err.Error()})
+			continue
+		}
+
+		switch req.Type {
+		case frameSubscribe:
+			s.subscribe(req)
+		case frameUnsubscribe:
+			s.unsubscribe(req)
+		case framePublish:
+			s.publish(req)
+		case frameAck:
+			s.ack(req)
+		default:
+			s.send(WebSocketResponse{Type: frameError, ID: req.ID, Topic: req.Topic, Error: "unknown frame type: " + req.Type})
+		}
+	}
+}
+
+func (s *wsSession) writeLoop(cancel context.CancelFunc) {
+	defer cancel()
+
+	ping := time.NewTicker(wsPingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case resp := <-s.out:
+			s.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := s.conn.WriteJSON(resp); err != nil {
+				s.conn.Close()
+				return
+			}
+		case <-ping.C:
+			s.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := s.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				s.conn.Close()
+				return
+			}
+		case <-s.ctx.Done():
+			s.conn.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+				time.Now().Add(wsWriteWait))
+			return
+		}
+	}
+}
+
+// send queues a frame for the writer and reports whether the session is still open.
+func (s *wsSession) send(resp WebSocketResponse) bool {
+	select {
+	case s.out <- resp:
+		return true
+	case <-s.ctx.Done():
+		return false
+	}
+}
+
+func (s *wsSession) subscribe(req WebSocketRequest) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subscriptions[req.Topic]; ok {
+		s.send(WebSocketResponse{Type: frameError, ID: req.ID, Topic: req.Topic, Error: "already subscribed"})
+		return
+	}
+
+	if req.GroupID != "" {
+		s.subscribeGroup(req)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	messages, _, err := s.client.Tail(ctx, s.tenant.Qualify(req.Topic), req.Offsets)
+	if err != nil {
+		cancel()
+		s.send(WebSocketResponse{Type: frameError, ID: req.ID, Topic: req.Topic, Error: err.Error()})
+		return
+	}
+	s.subscriptions[req.Topic] = &wsSubscription{cancel: cancel}
+
+	// Acknowledge before forwarding so the ack precedes the first message frame
+	s.send(WebSocketResponse{Type: frameAck, ID: req.ID, Topic: req.Topic})
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for msg := range messages {
+			resp := newMessageResponse(s.tenant, msg)
+			if !s.send(WebSocketResponse{Type: frameMessage, Topic: resp.Topic, Message: &resp}) {
+				cancel()
+			}
+		}
+	}()
+}
+
+// subscribeGroup joins the consumer group named in req. Callers must hold s.mu.
+func (s *wsSession) subscribeGroup(req WebSocketRequest) {
+	if len(req.Offsets) > 0 {
+		s.send(WebSocketResponse{Type: frameError, ID: req.ID, Topic: req.Topic, Error: "offsets cannot be combined with groupId, the group's committed offsets apply"})
+		return
+	}
+
+	sub, err := s.client.Subscribe(s.tenant.Qualify(req.GroupID), []string{s.tenant.Qualify(req.Topic)}, req.FromBeginning)
+	if err != nil {
+		s.send(WebSocketResponse{Type: frameError, ID: req.ID, Topic: req.Topic, Error: err.Error()})
+		return
+	}
+	ctx, cancel := context.WithCancel(s.ctx)
+	s.subscriptions[req.Topic] = &wsSubscription{cancel: cancel, group: sub}
+
+	// Acknowledge before forwarding so the ack precedes the first message frame
+	s.send(WebSocketResponse{Type: frameAck, ID: req.ID, Topic: req.Topic})
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer sub.Close()
+		for {
+			select {
+			case msg, ok := <-sub.Messages():
+				if !ok {
+					if err := sub.Err(); err != nil {
+						s.send(WebSocketResponse{Type: frameError, Topic: req.Topic, Error: err.Error()})
+					}
+					return
+				}
+				resp := newMessageResponse(s.tenant, msg)
+				if !s.send(WebSocketResponse{Type: frameMessage, Topic: resp.Topic, Message: &resp}) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (s *wsSession) unsubscribe(req WebSocketRequest) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub, ok := s.subscriptions[req.Topic]
+	if !ok {
+		s.send(WebSocketResponse{Type: frameError, ID: req.ID, Topic: req.Topic, Error: "not subscribed"})
+		return
+	}
+	sub.cancel()
+	delete(s.subscriptions, req.Topic)
+
+	s.send(WebSocketResponse{Type: frameAck, ID: req.ID, Topic: req.Topic})
+}
+
+// ack commits the offset following a processed record of a consumer group
+// subscription.
+func (s *wsSession) ack(req WebSocketRequest) {
+	if req.Partition == nil || req.Offset == nil {
+		s.send(WebSocketResponse{Type: frameError, ID: req.ID, Topic: req.Topic, Error: "partition and offset are required"})
+		return
+	}
+
+	s.mu.Lock()
+	sub, ok := s.subscriptions[req.Topic]
+	s.mu.Unlock()
+	if !ok || sub.group == nil {
+		s.send(WebSocketResponse{Type: frameError, ID: req.ID, Topic: req.Topic, Error: "not subscribed with a groupId"})
+		return
+	}
+
+	if err := sub.group.Ack(s.tenant.Qualify(req.Topic), *req.Partition, *req.Offset); err != nil {
+		s.send(WebSocketResponse{Type: frameError, ID: req.ID, Topic: req.Topic, Error: err.Error()})
+		return
+	}
+	s.send(WebSocketResponse{
+		Type:      frameAck,
+		ID:        req.ID,
+		Topic:     req.Topic,
+		Partition: req.Partition,
+		Offset:    req.Offset,
+	})
+}
+
+func (s *wsSession) publish(req WebSocketRequest) {
+	msg := newKafkaMessage(s.gin, MessageRequest{
+		Key:       req.Key,
+		Value:     req.Value,
+		Headers:   req.Headers,
+		Partition: req.Partition,
+	})
+
+	result, err := s.client.PublishMessage(s.tenant.Qualify(req.Topic), msg)
+	if err != nil {
+		s.send(WebSocketResponse{Type: frameError, ID: req.ID, Topic: req.Topic, Error: err.Error()})
+		return
+	}
+
+	s.send(WebSocketResponse{
+		Type:      frameAck,
+		ID:        req.ID,
+		Topic:     req.Topic,
+		Partition: &result.Partition,
+		Offset:    &result.Offset,
+	})
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOriginChecker(t *testing.T) {
+	check := newOriginChecker([]string{"https://Console.example.com/"})
+
+	tests := []struct {
+		name   string
+		origin string
+		host   string
+		want   bool
+	}{
+		{name: "no origin", origin: "", host: "gateway:8080", want: true},
+		{name: "same host", origin: "https://gateway:8080", host: "gateway:8080", want: true},
+		{name: "same host in other case", origin: "https://Gateway:8080", host: "gateway:8080", want: true},
+		{name: "allowed origin", origin: "https://console.example.com", host: "gateway:8080", want: true},
+		{name: "allowed host over other scheme", origin: "http://console.example.com", host: "gateway:8080", want: false},
+		{name: "other port", origin: "https://gateway:9090", host: "gateway:8080", want: false},
+		{name: "foreign origin", origin: "https://evil.example.com", host: "gateway:8080", want: false},
+		{name: "null origin", origin: "null", host: "gateway:8080", want: false},
+		{name: "malformed origin", origin: "https://%zz", host: "gateway:8080", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/api/v1/ws", nil)
+			r.Host = tt.host
+			if tt.origin != "" {
+				r.Header.Set("Origin", tt.origin)
+			}
+			if got := check(r); got != tt.want {
+				t.Fatalf("check(%q) = %v, want %v", tt.origin, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebSocketAckRequiresGroup(t *testing.T) {
+	partition, offset := int32(0), int64(42)
+
+	tests := []struct {
+		name string
+		req  WebSocketRequest
+		want string
+	}{
+		{
+			name: "missing offset",
+			req:  WebSocketRequest{Type: frameAck, ID: "1", Topic: "orders", Partition: &partition},
+			want: "partition and offset are required",
+		},
+		{
+			name: "tailing subscription",
+			req:  WebSocketRequest{Type: frameAck, ID: "2", Topic: "orders", Partition: &partition, Offset: &offset},
+			want: "not subscribed with a groupId",
+		},
+		{
+			name: "no subscription",
+			req:  WebSocketRequest{Type: frameAck, ID: "3", Topic: "payments", Partition: &partition, Offset: &offset},
+			want: "not subscribed with a groupId",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &wsSession{
+				ctx:           context.Background(),
+				out:           make(chan WebSocketResponse, 1),
+				subscriptions: map[string]*wsSubscription{"orders": {cancel: func() {}}},
+			}
+			s.ack(tt.req)
+
+			resp := <-s.out
+			if resp.Type != frameError || resp.ID != tt.req.ID || resp.Error != tt.want {
+				t.Fatalf("ack() sent %+v, want error %q for id %s", resp, tt.want, tt.req.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,350 @@
+package kafka
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+)
+
+// clusterResourceName is the only valid resource name for cluster ACLs.
+const clusterResourceName = "kafka-cluster"
+
+var (
+	ErrInvalidACL = errors.New("invalid ACL")
+	// ErrSecurityDisabled is returned by ACL operations on clusters without an authorizer.
+	ErrSecurityDisabled = errors.New("no authorizer is configured on the cluster")
+)
+
+// ACL grants or denies a principal an operation on a resource. Enum fields
+// take Kafka's names case-insensitively: resource types topic, group,
+// cluster, transactionalid and delegationtoken; pattern types literal and
+// prefixed; operations such as read, write, create, describe or all; and
+// permissions allow and deny.
+type ACL struct {
+	ResourceType string
+	ResourceName string
+	PatternType  string
+	Principal    string
+	Host         string
+	Operation    string
+	Permission   string
+}
+
+// ACLFilter selects ACLs. Empty fields match every ACL, and the pattern type
+// match selects every literal, wildcard and prefixed ACL that applies to
+// ResourceName.
+type ACLFilter struct {
+	ResourceType string
+	ResourceName string
+	PatternType  string
+	Principal    string
+	Host         string
+	Operation    string
+	Permission   string
+}
+
+// parseACLField decodes a Kafka enum name into v, leaving v untouched when name is empty.
+func parseACLField(field string, name string, v encoding.TextUnmarshaler) error {
+	if name == "" {
+		return nil
+	}
+	if err := v.UnmarshalText([]byte(name)); err != nil {
+		return fmt.Errorf("%w: unknown %s %q", ErrInvalidACL, field, name)
+	}
+	return nil
+}
+
+func newACL(resource sarama.Resource, acl sarama.Acl) ACL {
+	return ACL{
+		ResourceType: strings.ToLower(resource.ResourceType.String()),
+		ResourceName: resource.ResourceName,
+		PatternType:  strings.ToLower(resource.ResourcePatternType.String()),
+		Principal:    acl.Principal,
+		Host:         acl.Host,
+		Operation:    strings.ToLower(acl.Operation.String()),
+		Permission:   strings.ToLower(acl.PermissionType.String()),
+	}
+}
+
+// aclRequestVersion returns the ACL API version to use. Version 1, from
+// Kafka 2.0, adds pattern types.
+func (c *Client) aclRequestVersion() int {
+	if c.saramaConfig.Version.IsAtLeast(sarama.V2_0_0_0) {
+		return 1
+	}
+	return 0
+}
+
+func (c *Client) aclFilter(f ACLFilter) (*sarama.AclFilter, error) {
+	filter := &sarama.AclFilter{
+		ResourceType:              sarama.AclResourceAny,
+		ResourcePatternTypeFilter: sarama.AclPatternAny,
+		Operation:                 sarama.AclOperationAny,
+		PermissionType:            sarama.AclPermissionAny,
+	}
+	if err := parseACLField("resource type", f.ResourceType, &filter.ResourceType); err != nil {
+		return nil, err
+	}
+	if err := parseACLField("pattern type", f.PatternType, &filter.ResourcePatternTypeFilter); err != nil {
+		return nil, err
+	}
+	if err := parseACLField("operation", f.Operation, &filter.Operation); err != nil {
+		return nil, err
+	}
+	if err := parseACLField("permission", f.Permission, &filter.PermissionType); err != nil {
+		return nil, err
+	}
+	if f.ResourceName != "" {
+		filter.ResourceName = &f.ResourceName
+	}
+	if f.Principal != "" {
+		filter.Principal = &f.Principal
+	}
+	if f.Host != "" {
+		filter.Host = &f.Host
+	}
+	return filter, nil
+}
+
+// aclCreation validates acl and fills in the defaults: literal pattern type,
+// any host, and the fixed resource name of cluster ACLs.
+func (c *Client) aclCreation(acl ACL) (*sarama.AclCreation, error) {
+	creation := &sarama.AclCreation{
+		Resource: sarama.Resource{
+			ResourceName:        acl.ResourceName,
+			ResourcePatternType: sarama.AclPatternLiteral,
+		},
+		Acl: sarama.Acl{
+			Principal: acl.Principal,
+			Host:      acl.Host,
+		},
+	}
+	if err := parseACLField("resource type", acl.ResourceType, &creation.ResourceType); err != nil {
+		return nil, err
+	}
+	if err := parseACLField("pattern type", acl.PatternType, &creation.ResourcePatternType); err != nil {
+		return nil, err
+	}
+	if err := parseACLField("operation", acl.Operation, &creation.Operation); err != nil {
+		return nil, err
+	}
+	if err := parseACLField("permission", acl.Permission, &creation.PermissionType); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case creation.ResourceType <= sarama.AclResourceAny:
+		return nil, fmt.Errorf("%w: a resource type is required", ErrInvalidACL)
+	case creation.Operation <= sarama.AclOperationAny:
+		return nil, fmt.Errorf("%w: an operation is required", ErrInvalidACL)
+	case creation.PermissionType != sarama.AclPermissionAllow && creation.PermissionType != sarama.AclPermissionDeny:
+		return nil, fmt.Errorf("%w: permission must be allow or deny", ErrInvalidACL)
+	case creation.ResourcePatternType != sarama.AclPatternLiteral && creation.ResourcePatternType != sarama.AclPatternPrefixed:
+		return nil, fmt.Errorf("%w: pattern type must be literal or prefixed", ErrInvalidACL)
+	case creation.ResourcePatternType == sarama.AclPatternPrefixed && c.aclRequestVersion() < 1:
+		return nil, fmt.Errorf("%w: prefixed ACLs require Kafka 2.0 or later", ErrInvalidACL)
+	case !strings.Contains(acl.Principal, ":"):
+		return nil, fmt.Errorf("%w: principal must have the form <type>:<name>, e.g. User:alice", ErrInvalidACL)
+	}
+
+	if creation.ResourceType == sarama.AclResourceCluster && creation.ResourceName == "" {
+		creation.ResourceName = clusterResourceName
+	}
+	if creation.ResourceName == "" {
+		return nil, fmt.Errorf("%w: a resource name is required", ErrInvalidACL)
+	}
+	if creation.Host == "" {
+		creation.Host = "*"
+	}
+	return creation, nil
+}
+
+// matchesEverything reports whether filter selects every ACL of some resource
+// and principal: its name and principal are unset and its enum fields are
+// wildcards. Deleting with such a filter could wipe out the cluster's ACLs.
+func matchesEverything(filter *sarama.AclFilter) bool {
+	return filter.ResourceName == nil &&
+		filter.Principal == nil &&
+		filter.ResourceType <= sarama.AclResourceAny &&
+		filter.ResourcePatternTypeFilter <= sarama.AclPatternMatch &&
+		filter.Operation <= sarama.AclOperationAny &&
+		filter.PermissionType <= sarama.AclPermissionAny
+}
+
+// aclError translates an error code from an ACL response. sarama.ClusterAdmin
+// drops these codes, so the ACL operations send requests to the controller.
+func aclError(kerr sarama.KError, msg *string) error {
+	if kerr == sarama.ErrSecurityDisabled {
+		return ErrSecurityDisabled
+	}
+	if msg != nil && *msg != "" {
+		return fmt.Errorf("%w: %s", kerr, *msg)
+	}
+	return kerr
+}
+
+// ListACLs returns the ACLs matching filter, ordered by resource and principal.
+func (c *Client) ListACLs(f ACLFilter) ([]ACL, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return nil, err
+	}
+
+	filter, err := c.aclFilter(f)
+	if err != nil {
+		return nil, err
+	}
+
+	controller, err := c.admin.Controller()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find controller: %w", err)
+	}
+	resp, err := controller.DescribeAcls(&sarama.DescribeAclsRequest{
+		Version:   c.aclRequestVersion(),
+		AclFilter: *filter,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ACLs: %w", err)
+	}
+	if resp.Err != sarama.ErrNoError {
+		return nil, fmt.Errorf("failed to list ACLs: %w", aclError(resp.Err, resp.ErrMsg))
+	}
+
+	acls := make([]ACL, 0)
+	for _, r := range resp.ResourceAcls {
+		for _, a := range r.Acls {
+			acls = append(acls, newACL(r.Resource, *a))
+		}
+	}
+	sort.Slice(acls, func(i, j int) bool {
+		if acls[i].ResourceType != acls[j].ResourceType {
+			return acls[i].ResourceType < acls[j].ResourceType
+		}
+		if acls[i].ResourceName != acls[j].ResourceName {
+			return acls[i].ResourceName < acls[j].ResourceName
+		}
+		return acls[i].Principal < acls[j].Principal
+	})
+	return acls, nil
+}
+
+// CreateACLs adds acls. Creating an ACL that already exists is a no-op.
+// Kafka applies each ACL independently, so an error may leave some created.
+func (c *Client) CreateACLs(acls []ACL) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return err
+	}
+
+	if len(acls) == 0 {
+		return fmt.Errorf("%w: at least one ACL is required", ErrInvalidACL)
+	}
+	creations := make([]*sarama.AclCreation, len(acls))
+	for i, acl := range acls {
+		creation, err := c.aclCreation(acl)
+		if err != nil {
+			return err
+		}
+		creations[i] = creation
+	}
+
+	controller, err := c.admin.Controller()
+	if err != nil {
+		return fmt.Errorf("failed to find controller: %w", err)
+	}
+	resp, err := controller.CreateAcls(&sarama.CreateAclsRequest{
+		Version:      int16(c.aclRequestVersion()),
+		AclCreations: creations,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create ACLs: %w", err)
+	}
+
+	// Responses are in request order
+	var errs []string
+	for i, r := range resp.AclCreationResponses {
+		if r.Err == sarama.ErrSecurityDisabled {
+			return ErrSecurityDisabled
+		}
+		if r.Err != sarama.ErrNoError {
+			errs = append(errs, fmt.Sprintf("ACL %d: %v", i, aclError(r.Err, r.ErrMsg)))
+			continue
+		}
+		if i < len(creations) {
+			c.logger.Info("ACL created", aclFields(newACL(creations[i].Resource, creations[i].Acl))...)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to create ACLs: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// DeleteACLs removes every ACL matching filter and returns them. The filter
+// must name a resource or principal or narrow an enum field beyond its
+// wildcard, so a single call cannot wipe all ACLs.
+func (c *Client) DeleteACLs(f ACLFilter) ([]ACL, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return nil, err
+	}
+
+	filter, err := c.aclFilter(f)
+	if err != nil {
+		return nil, err
+	}
+	if matchesEverything(filter) {
+		return nil, fmt.Errorf("%w: deleting ACLs requires a resource name, a principal or a specific resource type, pattern type, operation or permission", ErrInvalidACL)
+	}
+
+	controller, err := c.admin.Controller()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find controller: %w", err)
+	}
+	resp, err := controller.DeleteAcls(&sarama.DeleteAclsRequest{
+		Version: c.aclRequestVersion(),
+		Filters: []*sarama.AclFilter{filter},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete ACLs: %w", err)
+	}
+
+	deleted := make([]ACL, 0)
+	for _, fr := range resp.FilterResponses {
+		if fr.Err != sarama.ErrNoError {
+			return nil, fmt.Errorf("failed to delete ACLs: %w", aclError(fr.Err, fr.ErrMsg))
+		}
+		for _, m := range fr.MatchingAcls {
+			if m.Err != sarama.ErrNoError {
+				return nil, fmt.Errorf("failed to delete ACL: %w", aclError(m.Err, m.ErrMsg))
+			}
+			acl := newACL(m.Resource, m.Acl)
+			c.logger.Info("ACL deleted", aclFields(acl)...)
+			deleted = append(deleted, acl)
+		}
+	}
+	return deleted, nil
+}
+
+func aclFields(acl ACL) []zap.Field {
+	return []zap.Field{
+		zap.String("resource_type", acl.ResourceType),
+		zap.String("resource_name", acl.ResourceName),
+		zap.String("pattern_type", acl.PatternType),
+		zap.String("principal", acl.Principal),
+		zap.String("host", acl.Host),
+		zap.String("operation", acl.Operation),
+		zap.String("permission", acl.Permission),
+	}
+}
@@ -0,0 +1,197 @@
+package kafka
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Shopify/sarama"
+)
+
+func newACLTestClient(version sarama.KafkaVersion) *Client {
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.Version = version
+	return &Client{saramaConfig: saramaConfig}
+}
+
+func TestACLFilter(t *testing.T) {
+	c := newACLTestClient(sarama.V2_0_0_0)
+
+	tests := []struct {
+		name    string
+		filter  ACLFilter
+		want    sarama.AclFilter
+		wantErr bool
+	}{
+		{
+			name: "empty filter selects everything",
+			want: sarama.AclFilter{
+				ResourceType:              sarama.AclResourceAny,
+				ResourcePatternTypeFilter: sarama.AclPatternAny,
+				Operation:                 sarama.AclOperationAny,
+				PermissionType:            sarama.AclPermissionAny,
+			},
+		},
+		{
+			name:   "enum names are case-insensitive",
+			filter: ACLFilter{ResourceType: "Topic", PatternType: "PREFIXED", Operation: "read", Permission: "Allow"},
+			want: sarama.AclFilter{
+				ResourceType:              sarama.AclResourceTopic,
+				ResourcePatternTypeFilter: sarama.AclPatternPrefixed,
+				Operation:                 sarama.AclOperationRead,
+				PermissionType:            sarama.AclPermissionAllow,
+			},
+		},
+		{name: "unknown resource type", filter: ACLFilter{ResourceType: "table"}, wantErr: true},
+		{name: "unknown pattern type", filter: ACLFilter{PatternType: "glob"}, wantErr: true},
+		{name: "unknown operation", filter: ACLFilter{Operation: "truncate"}, wantErr: true},
+		{name: "unknown permission", filter: ACLFilter{Permission: "maybe"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.aclFilter(tt.filter)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidACL) {
+					t.Fatalf("aclFilter() error = %v, want %v", err, ErrInvalidACL)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("aclFilter() error = %v", err)
+			}
+			if got.ResourceType != tt.want.ResourceType ||
+				got.ResourcePatternTypeFilter != tt.want.ResourcePatternTypeFilter ||
+				got.Operation != tt.want.Operation ||
+				got.PermissionType != tt.want.PermissionType {
+				t.Fatalf("aclFilter() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestACLFilterStrings(t *testing.T) {
+	c := newACLTestClient(sarama.V2_0_0_0)
+
+	got, err := c.aclFilter(ACLFilter{ResourceName: "orders", Principal: "User:alice", Host: "10.0.0.1"})
+	if err != nil {
+		t.Fatalf("aclFilter() error = %v", err)
+	}
+	if got.ResourceName == nil || *got.ResourceName != "orders" ||
+		got.Principal == nil || *got.Principal != "User:alice" ||
+		got.Host == nil || *got.Host != "10.0.0.1" {
+		t.Fatalf("aclFilter() = %+v, want name, principal and host set", got)
+	}
+
+	got, err = c.aclFilter(ACLFilter{})
+	if err != nil {
+		t.Fatalf("aclFilter() error = %v", err)
+	}
+	if got.ResourceName != nil || got.Principal != nil || got.Host != nil {
+		t.Fatalf("aclFilter() = %+v, want nil name, principal and host", got)
+	}
+}
+
+// TestDeleteFilterMatchesEverything covers the guard that keeps DeleteACLs
+// from removing every ACL of the cluster.
+func TestDeleteFilterMatchesEverything(t *testing.T) {
+	c := newACLTestClient(sarama.V2_0_0_0)
+
+	tests := []struct {
+		name   string
+		filter ACLFilter
+		want   bool
+	}{
+		{name: "empty", filter: ACLFilter{}, want: true},
+		{name: "explicit wildcards", filter: ACLFilter{ResourceType: "any", PatternType: "any", Operation: "any", Permission: "any"}, want: true},
+		{name: "pattern type match", filter: ACLFilter{PatternType: "match"}, want: true},
+		{name: "host only", filter: ACLFilter{Host: "*"}, want: true},
+		{name: "resource name", filter: ACLFilter{ResourceName: "orders"}},
+		{name: "principal", filter: ACLFilter{Principal: "User:alice"}},
+		{name: "resource type", filter: ACLFilter{ResourceType: "topic"}},
+		{name: "pattern type", filter: ACLFilter{PatternType: "prefixed"}},
+		{name: "operation", filter: ACLFilter{Operation: "write"}},
+		{name: "permission", filter: ACLFilter{Permission: "deny"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := c.aclFilter(tt.filter)
+			if err != nil {
+				t.Fatalf("aclFilter() error = %v", err)
+			}
+			if got := matchesEverything(filter); got != tt.want {
+				t.Fatalf("matchesEverything() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestACLCreation(t *testing.T) {
+	valid := ACL{ResourceType: "topic", ResourceName: "orders", Principal: "User:alice", Operation: "read", Permission: "allow"}
+
+	tests := []struct {
+		name    string
+		version sarama.KafkaVersion
+		acl     func(ACL) ACL
+		want    func(*testing.T, *sarama.AclCreation)
+		wantErr bool
+	}{
+		{
+			name: "defaults",
+			acl:  func(a ACL) ACL { return a },
+			want: func(t *testing.T, got *sarama.AclCreation) {
+				if got.ResourcePatternType != sarama.AclPatternLiteral || got.Host != "*" {
+					t.Fatalf("aclCreation() = %+v, want literal pattern and any host", got)
+				}
+			},
+		},
+		{
+			name: "cluster resource name",
+			acl: func(a ACL) ACL {
+				a.ResourceType, a.ResourceName, a.Operation = "cluster", "", "alter"
+				return a
+			},
+			want: func(t *testing.T, got *sarama.AclCreation) {
+				if got.ResourceName != clusterResourceName {
+					t.Fatalf("aclCreation() resource name = %q, want %q", got.ResourceName, clusterResourceName)
+				}
+			},
+		},
+		{
+			name: "prefixed",
+			acl:  func(a ACL) ACL { a.PatternType = "prefixed"; return a },
+			want: func(t *testing.T, got *sarama.AclCreation) {
+				if got.ResourcePatternType != sarama.AclPatternPrefixed {
+					t.Fatalf("aclCreation() pattern type = %v, want prefixed", got.ResourcePatternType)
+				}
+			},
+		},
+		{name: "prefixed before Kafka 2.0", version: sarama.V1_1_0_0, acl: func(a ACL) ACL { a.PatternType = "prefixed"; return a }, wantErr: true},
+		{name: "missing resource type", acl: func(a ACL) ACL { a.ResourceType = ""; return a }, wantErr: true},
+		{name: "any resource type", acl: func(a ACL) ACL { a.ResourceType = "any"; return a }, wantErr: true},
+		{name: "missing resource name", acl: func(a ACL) ACL { a.ResourceName = ""; return a }, wantErr: true},
+		{name: "missing operation", acl: func(a ACL) ACL { a.Operation = ""; return a }, wantErr: true},
+		{name: "any operation", acl: func(a ACL) ACL { a.Operation = "any"; return a }, wantErr: true},
+		{name: "missing permission", acl: func(a ACL) ACL { a.Permission = ""; return a }, wantErr: true},
+		{name: "any permission", acl: func(a ACL) ACL { a.Permission = "any"; return a }, wantErr: true},
+		{name: "match pattern type", acl: func(a ACL) ACL { a.PatternType = "match"; return a }, wantErr: true},
+		{name: "principal without type", acl: func(a ACL) ACL { a.Principal = "alice"; return a }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version := tt.version
+			if version == (sarama.KafkaVersion{}) {
+				version = sarama.V2_0_0_0
+			}
+			got, err := newACLTestClient(version).aclCreation(tt.acl(valid))
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidACL) {
+					t.Fatalf("aclCreation() error = %v, want %v", err, ErrInvalidACL)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("aclCreation() error = %v", err)
+			}
+			tt.want(t, got)
+		})
+	}
+}
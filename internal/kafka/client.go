@@ -3,13 +3,15 @@ package kafka
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
-	"io/ioutil"
 	"kafka-gateway/internal/config"
+	"os"
 	"sync"
 	"time"
 
 	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
 )
 
 func createTLSConfig(tlsConfig *config.KafkaTLSConfig) (*tls.Config, error) {
@@ -17,41 +19,97 @@ func createTLSConfig(tlsConfig *config.KafkaTLSConfig) (*tls.Config, error) {
 		return nil, fmt.Errorf("TLS config is required")
 	}
 
-	// Load client cert
-	cert, err := tls.LoadX509KeyPair("certs/client/client.crt", "certs/client/client.key")
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         tlsConfig.ServerName,
+		InsecureSkipVerify: tlsConfig.InsecureSkipVerify,
+	}
+
+	// Load client cert, only needed when brokers require mTLS
+	certPEM, err := loadPEM(tlsConfig.ClientCertPEM, tlsConfig.ClientCert)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client certificate: %w", err)
+	}
+	keyPEM, err := loadPEM(tlsConfig.ClientKeyPEM, tlsConfig.ClientKey)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		return nil, fmt.Errorf("failed to read client key: %w", err)
+	}
+	if (certPEM == nil) != (keyPEM == nil) {
+		return nil, fmt.Errorf("client certificate and key must be configured together")
+	}
+	if certPEM != nil {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
 	}
 
-	// Load CA cert
-	caCert, err := ioutil.ReadFile("certs/ca/ca.crt")
+	// Load CA cert, falling back to the system roots
+	caCert, err := loadPEM(tlsConfig.CACertPEM, tlsConfig.CACert)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read CA certificate: %w", err)
 	}
-
-	caCertPool := x509.NewCertPool()
-	if !caCertPool.AppendCertsFromPEM(caCert) {
-		return nil, fmt.Errorf("failed to parse CA certificate")
+	if caCert != nil {
+		caCertPool := x509.NewCertPool()
+		if !caCertPool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("failed to parse CA certificate")
+		}
+		cfg.RootCAs = caCertPool
 	}
 
-	return &tls.Config{
-		Certificates: []tls.Certificate{cert},
-		RootCAs:      caCertPool,
-		MinVersion:   tls.VersionTLS12,
-	}, nil
+	return cfg, nil
+}
+
+// loadPEM returns inline PEM material if set, otherwise the contents of path.
+func loadPEM(inline string, path string) ([]byte, error) {
+	if inline != "" {
+		return []byte(inline), nil
+	}
+	if path != "" {
+		return os.ReadFile(path)
+	}
+	return nil, nil
 }
 
 type Client struct {
-	config   *config.KafkaConfig
-	producer sarama.SyncProducer
-	admin    sarama.ClusterAdmin
-	mu       sync.RWMutex
+	config       *config.KafkaConfig
+	saramaConfig *sarama.Config
+	client       sarama.Client
+	producer     sarama.SyncProducer
+	admin        sarama.ClusterAdmin
+	logger       *zap.Logger
+	mu           sync.RWMutex
+	stop         chan struct{}
+	closeOnce    sync.Once
+
+	subscriptions map[string]*Subscription
+	subsMu        sync.Mutex
+
+	lastProbeSuccess time.Time
+	probing          *probeCall
+	probeMu          sync.Mutex
 }
 
-func NewClient(cfg config.KafkaConfig) (*Client, error) {
+func NewClient(cfg config.KafkaConfig, logger *zap.Logger) (*Client, error) {
 	config := sarama.NewConfig()
 
+	// Record headers require Kafka 0.11 or later
+	version, err := sarama.ParseKafkaVersion(cfg.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kafka version %q: %w", cfg.Version, err)
+	}
+	if !version.IsAtLeast(sarama.V0_11_0_0) {
+		return nil, fmt.Errorf("kafka version %s is too old, 0.11.0 or later is required", cfg.Version)
+	}
+	config.Version = version
+
 	// Producer configs
+	partitioner, err := newPartitionerConstructor(cfg)
+	if err != nil {
+		return nil, err
+	}
+	config.Producer.Partitioner = partitioner
 	config.Producer.RequiredAcks = sarama.WaitForAll
 	config.Producer.Retry.Max = 5
 	config.Producer.Return.Successes = true
@@ -63,74 +121,201 @@ func NewClient(cfg config.KafkaConfig) (*Client, error) {
 	config.Metadata.Retry.Max = 3
 	config.Metadata.Retry.Backoff = 1 * time.Second
 
+	// Consumer group configs
+	config.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{sarama.BalanceStrategySticky}
+	config.Consumer.Offsets.AutoCommit.Enable = false
+
+	switch cfg.SecurityProtocol {
+	case "", "PLAINTEXT", "SSL", "SASL_PLAINTEXT", "SASL_SSL":
+	default:
+		return nil, fmt.Errorf("unsupported security protocol %q", cfg.SecurityProtocol)
+	}
+
 	// Configure TLS if enabled
-	if cfg.SecurityProtocol == "SSL" {
+	if cfg.SecurityProtocol == "SSL" || cfg.SecurityProtocol == "SASL_SSL" {
 		tlsConfig, err := createTLSConfig(cfg.TLS)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create TLS config: %w", err)
 		}
 		config.Net.TLS.Enable = true
 		config.Net.TLS.Config = tlsConfig
+		if tlsConfig.InsecureSkipVerify {
+			logger.Warn("Kafka broker certificate verification is disabled")
+		}
 	}
 
-	// Create producer
-	producer, err := sarama.NewSyncProducer(cfg.Brokers, config)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create producer: %w", err)
+	// Configure SASL if enabled
+	if cfg.SecurityProtocol == "SASL_PLAINTEXT" || cfg.SecurityProtocol == "SASL_SSL" {
+		if err := configureSASL(config, cfg); err != nil {
+			return nil, fmt.Errorf("failed to configure SASL: %w", err)
+		}
 	}
 
-	// Create admin client
-	admin, err := sarama.NewClusterAdmin(cfg.Brokers, config)
-	if err != nil {
-		producer.Close()
-		return nil, fmt.Errorf("failed to create admin client: %w", err)
+	c := &Client{
+		config:        &cfg,
+		saramaConfig:  config,
+		logger:        logger,
+		stop:          make(chan struct{}),
+		subscriptions: make(map[string]*Subscription),
 	}
 
-	return &Client{
-		config:   &cfg,
-		producer: producer,
-		admin:    admin,
-	}, nil
+	// Connect in the background so a cluster that is down at startup does
+	// not prevent the gateway from serving; calls fail with ErrUnavailable
+	// until the first connection succeeds.
+	go c.supervise()
+
+	return c, nil
 }
 
+// Close stops reconnecting and releases the connection. Only the first call
+// has an effect, so a client may be closed both directly and by its Registry.
 func (c *Client) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		err = c.close()
+	})
+	return err
+}
+
+func (c *Client) close() error {
+	close(c.stop)
+	c.closeSubscriptions()
+
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	if c.client == nil {
+		return nil
+	}
 	if err := c.producer.Close(); err != nil {
 		return fmt.Errorf("failed to close producer: %w", err)
 	}
+	// Closing the admin client also closes the client it was built on
 	if err := c.admin.Close(); err != nil {
 		return fmt.Errorf("failed to close admin client: %w", err)
 	}
 	return nil
 }
 
-func (c *Client) PublishMessage(topic string, key []byte, value []byte) error {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
+func newProducerMessage(topic string, m *Message) *sarama.ProducerMessage {
 	msg := &sarama.ProducerMessage{
 		Topic: topic,
-		Value: sarama.ByteEncoder(value),
+		Value: sarama.ByteEncoder(m.Value),
+		// Brokers using LogAppendTime overwrite this with their own timestamp
+		Timestamp: time.Now(),
 	}
-	if key != nil {
-		msg.Key = sarama.ByteEncoder(key)
+	if m.Key != nil {
+		msg.Key = sarama.ByteEncoder(m.Key)
 	}
+	if m.TargetPartition != nil {
+		msg.Partition = *m.TargetPartition
+		msg.Metadata = explicitPartition{}
+	}
+	for k, v := range m.Headers {
+		msg.Headers = append(msg.Headers, sarama.RecordHeader{
+			Key:   []byte(k),
+			Value: []byte(v),
+		})
+	}
+	return msg
+}
+
+func (c *Client) PublishMessage(topic string, m *Message) (*PublishResult, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return nil, err
+	}
+
+	msg := newProducerMessage(topic, m)
 
 	partition, offset, err := c.producer.SendMessage(msg)
 	if err != nil {
-		return fmt.Errorf("failed to publish message: %w", err)
+		return nil, fmt.Errorf("failed to publish message: %w", err)
 	}
 
-	fmt.Printf("Message published to topic %s, partition %d, offset %d\n", topic, partition, offset)
-	return nil
+	c.logger.Debug("Message published",
+		zap.String("topic", topic),
+		zap.Int32("partition", partition),
+		zap.Int64("offset", offset),
+	)
+	return &PublishResult{
+		Partition: partition,
+		Offset:    offset,
+		Timestamp: msg.Timestamp,
+	}, nil
+}
+
+// MaxBatchSize is the largest number of messages accepted by PublishBatch.
+const MaxBatchSize = 1000
+
+// PublishResult reports where a message was written, or why it failed.
+type PublishResult struct {
+	Partition int32
+	Offset    int64
+	Timestamp time.Time
+	Err       error
+}
+
+// PublishBatch sends all messages to topic in a single producer call and
+// returns one result per message, in input order.
+func (c *Client) PublishBatch(topic string, messages []*Message) ([]PublishResult, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return nil, err
+	}
+
+	if len(messages) > MaxBatchSize {
+		return nil, fmt.Errorf("batch of %d messages exceeds the maximum of %d", len(messages), MaxBatchSize)
+	}
+
+	msgs := make([]*sarama.ProducerMessage, len(messages))
+	index := make(map[*sarama.ProducerMessage]int, len(messages))
+	for i, m := range messages {
+		msg := newProducerMessage(topic, m)
+		msgs[i] = msg
+		index[msg] = i
+	}
+
+	results := make([]PublishResult, len(msgs))
+	if err := c.producer.SendMessages(msgs); err != nil {
+		var producerErrs sarama.ProducerErrors
+		if !errors.As(err, &producerErrs) {
+			return nil, fmt.Errorf("failed to publish batch: %w", err)
+		}
+		for _, perr := range producerErrs {
+			if i, ok := index[perr.Msg]; ok {
+				results[i].Err = perr.Err
+			}
+		}
+	}
+
+	for i, msg := range msgs {
+		if results[i].Err == nil {
+			results[i].Partition = msg.Partition
+			results[i].Offset = msg.Offset
+			results[i].Timestamp = msg.Timestamp
+		}
+	}
+
+	c.logger.Debug("Batch published",
+		zap.String("topic", topic),
+		zap.Int("messages", len(msgs)),
+	)
+	return results, nil
 }
 
 func (c *Client) ListTopics() ([]string, error) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
+	if err := c.available(); err != nil {
+		return nil, err
+	}
+
 	topics, err := c.admin.ListTopics()
 	if err != nil {
 		return nil, fmt.Errorf("failed to list topics: %w", err)
@@ -147,35 +332,377 @@ func (c *Client) GetTopicPartitions(topic string) ([]int32, error) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	metadata, err := c.admin.DescribeTopics([]string{topic})
-	if err != nil {
-		return nil, fmt.Errorf("failed to get topic metadata: %w", err)
+	if err := c.available(); err != nil {
+		return nil, err
 	}
 
-	if len(metadata) == 0 {
-		return nil, fmt.Errorf("topic not found: %s", topic)
+	metadata, err := c.describeTopic(topic)
+	if err != nil {
+		return nil, err
 	}
 
-	partitions := make([]int32, len(metadata[0].Partitions))
-	for i, partition := range metadata[0].Partitions {
+	partitions := make([]int32, len(metadata.Partitions))
+	for i, partition := range metadata.Partitions {
 		partitions[i] = partition.ID
 	}
 	return partitions, nil
 }
 
-func (c *Client) CreateTopic(topic string, numPartitions int32, replicationFactor int16) error {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+// When checking for keyed data, CreatePartitions reads up to keySampleWindow
+// records before the end of each partition. keySampleTimeout bounds the wait
+// on one partition and keySampleBudget the whole check. The window reaches
+// past transaction markers, which consumers never deliver.
+const (
+	keySampleWindow  = 10
+	keySampleTimeout = 2 * time.Second
+	keySampleBudget  = 5 * time.Second
+)
+
+var ErrInvalidPartitionCount = errors.New("invalid partition count")
+
+// KeyedDataWarning explains the consequence of PartitionIncrease.KeyedData to API callers.
+const KeyedDataWarning = "topic contains keyed records; adding partitions changes which partition each key is written to, so per-key ordering is not preserved across the change"
+
+// PartitionIncrease reports the outcome of CreatePartitions.
+type PartitionIncrease struct {
+	PreviousCount int32
+	Count         int32
+	// KeyedData is set when the latest record of an existing partition has a
+	// key. Adding partitions changes where those keys are written from now on.
+	KeyedData bool
+}
+
+// CreatePartitions grows topic to count partitions. An optional assignment
+// lists the brokers for each new partition, in order.
+func (c *Client) CreatePartitions(topic string, count int32, assignment [][]int32, validateOnly bool) (*PartitionIncrease, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return nil, err
+	}
+
+	metadata, err := c.describeTopic(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	current := int32(len(metadata.Partitions))
+	if count <= current {
+		return nil, fmt.Errorf("%w: topic %s already has %d partitions, the new count must be higher", ErrInvalidPartitionCount, topic, current)
+	}
+	if len(assignment) > 0 {
+		if int32(len(assignment)) != count-current {
+			return nil, fmt.Errorf("%w: %d partitions assigned but %d are being added", ErrInvalidAssignment, len(assignment), count-current)
+		}
+		if _, err := validateAssignment(assignment); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &PartitionIncrease{
+		PreviousCount: current,
+		Count:         count,
+		KeyedData:     c.hasKeyedData(topic, metadata.Partitions),
+	}
+
+	if err := c.admin.CreatePartitions(topic, count, assignment, validateOnly); err != nil {
+		return nil, fmt.Errorf("failed to create partitions: %w", wrapTopicError(topic, err))
+	}
+
+	if !validateOnly {
+		c.logger.Info("Partitions created",
+			zap.String("topic", topic),
+			zap.Int32("previous", current),
+			zap.Int32("count", count),
+		)
+	}
+	return result, nil
+}
+
+// hasKeyedData samples the latest records of each partition and reports
+// whether any of them has a key. Partitions that cannot be read in time are
+// skipped, so a false result is best effort.
+func (c *Client) hasKeyedData(topic string, partitions []*sarama.PartitionMetadata) bool {
+	consumer, err := sarama.NewConsumerFromClient(c.client)
+	if err != nil {
+		return false
+	}
+	defer consumer.Close()
+
+	deadline := time.Now().Add(keySampleBudget)
+	for _, p := range partitions {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return false
+		}
+		if c.sampleKeys(consumer, topic, p.ID, min(remaining, keySampleTimeout)) {
+			return true
+		}
+	}
+	return false
+}
+
+// sampleKeys reads the last keySampleWindow records of a partition until one
+// has a key, the end is reached or timeout expires.
+func (c *Client) sampleKeys(consumer sarama.Consumer, topic string, partition int32, timeout time.Duration) bool {
+	oldest, err := c.client.GetOffset(topic, partition, sarama.OffsetOldest)
+	if err != nil {
+		return false
+	}
+	newest, err := c.client.GetOffset(topic, partition, sarama.OffsetNewest)
+	if err != nil || newest <= oldest {
+		return false
+	}
+
+	start := newest - keySampleWindow
+	if start < oldest {
+		start = oldest
+	}
+	pc, err := consumer.ConsumePartition(topic, partition, start)
+	if err != nil {
+		return false
+	}
+	defer pc.Close()
+
+	expired := time.After(timeout)
+	for {
+		select {
+		case msg := <-pc.Messages():
+			if msg.Key != nil {
+				return true
+			}
+			if msg.Offset >= newest-1 {
+				return false
+			}
+		case <-expired:
+			return false
+		}
+	}
+}
+
+var ErrInvalidAssignment = errors.New("invalid replica assignment")
+
+// TopicSpec describes a topic to create. When ReplicaAssignment is set, entry
+// i lists the broker IDs hosting partition i, and NumPartitions and
+// ReplicationFactor may be left zero.
+type TopicSpec struct {
+	NumPartitions     int32
+	ReplicationFactor int16
+	ConfigEntries     map[string]string
+	ReplicaAssignment [][]int32
+	// ValidateOnly asks the controller to check the request without creating the topic.
+	ValidateOnly bool
+}
+
+// validateAssignment checks that every partition has the same, non-zero
+// number of distinct replicas and returns that replication factor.
+func validateAssignment(assignment [][]int32) (int16, error) {
+	replicationFactor := len(assignment[0])
+	for i, replicas := range assignment {
+		if len(replicas) == 0 {
+			return 0, fmt.Errorf("%w: partition %d has no replicas", ErrInvalidAssignment, i)
+		}
+		if len(replicas) != replicationFactor {
+			return 0, fmt.Errorf("%w: partition %d has %d replicas, expected %d", ErrInvalidAssignment, i, len(replicas), replicationFactor)
+		}
+		seen := make(map[int32]bool, len(replicas))
+		for _, broker := range replicas {
+			if seen[broker] {
+				return 0, fmt.Errorf("%w: broker %d is listed twice for partition %d", ErrInvalidAssignment, broker, i)
+			}
+			seen[broker] = true
+		}
+	}
+	return int16(replicationFactor), nil
+}
+
+func (c *Client) CreateTopic(topic string, spec TopicSpec) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return err
+	}
 
 	topicDetail := &sarama.TopicDetail{
-		NumPartitions:     numPartitions,
-		ReplicationFactor: replicationFactor,
+		NumPartitions:     spec.NumPartitions,
+		ReplicationFactor: spec.ReplicationFactor,
+	}
+
+	if len(spec.ReplicaAssignment) > 0 {
+		replicationFactor, err := validateAssignment(spec.ReplicaAssignment)
+		if err != nil {
+			return err
+		}
+		if spec.NumPartitions != 0 && spec.NumPartitions != int32(len(spec.ReplicaAssignment)) {
+			return fmt.Errorf("%w: %d partitions assigned but numPartitions is %d", ErrInvalidAssignment, len(spec.ReplicaAssignment), spec.NumPartitions)
+		}
+		if spec.ReplicationFactor != 0 && spec.ReplicationFactor != replicationFactor {
+			return fmt.Errorf("%w: %d replicas assigned but replicationFactor is %d", ErrInvalidAssignment, replicationFactor, spec.ReplicationFactor)
+		}
+
+		// The controller rejects explicit counts alongside an assignment
+		topicDetail.NumPartitions = -1
+		topicDetail.ReplicationFactor = -1
+		topicDetail.ReplicaAssignment = make(map[int32][]int32, len(spec.ReplicaAssignment))
+		for i, replicas := range spec.ReplicaAssignment {
+			topicDetail.ReplicaAssignment[int32(i)] = replicas
+		}
+	}
+
+	if len(spec.ConfigEntries) > 0 {
+		topicDetail.ConfigEntries = make(map[string]*string, len(spec.ConfigEntries))
+		for name, value := range spec.ConfigEntries {
+			value := value
+			topicDetail.ConfigEntries[name] = &value
+		}
 	}
 
-	err := c.admin.CreateTopic(topic, topicDetail, false)
+	err := c.admin.CreateTopic(topic, topicDetail, spec.ValidateOnly)
 	if err != nil {
 		return fmt.Errorf("failed to create topic: %w", err)
 	}
 
 	return nil
 }
+
+func (c *Client) DeleteTopic(topic string) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return err
+	}
+
+	if err := c.admin.DeleteTopic(topic); err != nil {
+		return fmt.Errorf("failed to delete topic: %w", wrapTopicError(topic, err))
+	}
+
+	c.logger.Info("Topic deleted", zap.String("topic", topic))
+	return nil
+}
+
+// Config alteration operations accepted by AlterTopicConfig.
+const (
+	ConfigOperationSet      = "set"
+	ConfigOperationDelete   = "delete"
+	ConfigOperationAppend   = "append"
+	ConfigOperationSubtract = "subtract"
+)
+
+var ErrInvalidConfig = errors.New("invalid config alteration")
+
+// ConfigEntry is a single topic configuration value.
+type ConfigEntry struct {
+	Name      string
+	Value     string
+	Default   bool
+	ReadOnly  bool
+	Sensitive bool
+	Source    string
+}
+
+// ConfigAlteration changes one configuration key. Value is ignored for delete
+// and required otherwise; append and subtract apply to list-valued keys.
+type ConfigAlteration struct {
+	Name      string
+	Value     *string
+	Operation string
+}
+
+func (c *Client) DescribeTopicConfig(topic string) ([]ConfigEntry, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return nil, err
+	}
+
+	entries, err := c.admin.DescribeConfig(sarama.ConfigResource{
+		Type: sarama.TopicResource,
+		Name: topic,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe topic config: %w", wrapTopicError(topic, err))
+	}
+
+	return newConfigEntries(entries), nil
+}
+
+// newConfigEntries converts sarama config entries, blanking sensitive values.
+func newConfigEntries(entries []sarama.ConfigEntry) []ConfigEntry {
+	configs := make([]ConfigEntry, len(entries))
+	for i, entry := range entries {
+		configs[i] = ConfigEntry{
+			Name:      entry.Name,
+			Value:     entry.Value,
+			Default:   entry.Default,
+			ReadOnly:  entry.ReadOnly,
+			Sensitive: entry.Sensitive,
+			Source:    entry.Source.String(),
+		}
+		if entry.Sensitive {
+			configs[i].Value = ""
+		}
+	}
+	return configs
+}
+
+// AlterTopicConfig applies alterations with incremental semantics: keys that
+// are not mentioned keep their current values.
+func (c *Client) AlterTopicConfig(topic string, alterations []ConfigAlteration) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return err
+	}
+
+	if len(alterations) == 0 {
+		return fmt.Errorf("%w: at least one config is required", ErrInvalidConfig)
+	}
+
+	entries := make(map[string]sarama.IncrementalAlterConfigsEntry, len(alterations))
+	for _, a := range alterations {
+		if a.Name == "" {
+			return fmt.Errorf("%w: config name is required", ErrInvalidConfig)
+		}
+		if _, ok := entries[a.Name]; ok {
+			return fmt.Errorf("%w: %s is altered more than once", ErrInvalidConfig, a.Name)
+		}
+
+		var op sarama.IncrementalAlterConfigsOperation
+		switch a.Operation {
+		case "", ConfigOperationSet:
+			op = sarama.IncrementalAlterConfigsOperationSet
+		case ConfigOperationDelete:
+			op = sarama.IncrementalAlterConfigsOperationDelete
+		case ConfigOperationAppend:
+			op = sarama.IncrementalAlterConfigsOperationAppend
+		case ConfigOperationSubtract:
+			op = sarama.IncrementalAlterConfigsOperationSubtract
+		default:
+			return fmt.Errorf("%w: unknown operation %q for %s", ErrInvalidConfig, a.Operation, a.Name)
+		}
+		if op != sarama.IncrementalAlterConfigsOperationDelete && a.Value == nil {
+			return fmt.Errorf("%w: value is required for %s", ErrInvalidConfig, a.Name)
+		}
+
+		entry := sarama.IncrementalAlterConfigsEntry{Operation: op}
+		if op != sarama.IncrementalAlterConfigsOperationDelete {
+			entry.Value = a.Value
+		}
+		entries[a.Name] = entry
+	}
+
+	if err := c.admin.IncrementalAlterConfig(sarama.TopicResource, topic, entries, false); err != nil {
+		return fmt.Errorf("failed to alter topic config: %w", wrapTopicError(topic, err))
+	}
+
+	c.logger.Info("Topic config altered",
+		zap.String("topic", topic),
+		zap.Int("configs", len(entries)),
+	)
+	return nil
+}
@@ -0,0 +1,40 @@
+package kafka
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateAssignment(t *testing.T) {
+	tests := []struct {
+		name       string
+		assignment [][]int32
+		want       int16
+		wantErr    bool
+	}{
+		{name: "single replica", assignment: [][]int32{{1}, {2}, {3}}, want: 1},
+		{name: "three replicas", assignment: [][]int32{{1, 2, 3}, {2, 3, 1}}, want: 3},
+		{name: "no replicas", assignment: [][]int32{{}}, wantErr: true},
+		{name: "later partition without replicas", assignment: [][]int32{{1, 2}, {}}, wantErr: true},
+		{name: "uneven replication", assignment: [][]int32{{1, 2}, {1}}, wantErr: true},
+		{name: "more replicas than the first", assignment: [][]int32{{1}, {1, 2}}, wantErr: true},
+		{name: "duplicate broker", assignment: [][]int32{{1, 2}, {3, 3}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validateAssignment(tt.assignment)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidAssignment) {
+					t.Fatalf("validateAssignment() error = %v, want %v", err, ErrInvalidAssignment)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("validateAssignment() error = %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("validateAssignment() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
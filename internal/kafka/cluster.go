@@ -0,0 +1,191 @@
+package kafka
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/Shopify/sarama"
+)
+
+var ErrBrokerNotFound = errors.New("broker not found")
+
+// BrokerDescription identifies one broker. Configs is only filled in when
+// requested from DescribeCluster.
+type BrokerDescription struct {
+	ID         int32
+	Address    string
+	Rack       string
+	Controller bool
+	Configs    []ConfigEntry
+}
+
+// ClusterDescription is the identity and membership of the cluster.
+type ClusterDescription struct {
+	ClusterID    string
+	ControllerID int32
+	Brokers      []BrokerDescription
+}
+
+// LogDirPartition is the on-disk size of one partition replica. OffsetLag is
+// how far the replica's log end trails the high watermark, and Temporary
+// marks a future replica being moved between log directories.
+type LogDirPartition struct {
+	Topic     string
+	Partition int32
+	Size      int64
+	OffsetLag int64
+	Temporary bool
+}
+
+// LogDir is a log directory of a broker. Error is set for offline directories.
+// Size counts every partition in the directory, and PartitionsSize only those
+// listed in Partitions, which differ when a topic is given.
+type LogDir struct {
+	BrokerID       int32
+	Path           string
+	Error          string
+	Size           int64
+	PartitionsSize int64
+	Partitions     []LogDirPartition
+}
+
+// DescribeCluster returns the cluster ID, the controller and every live
+// broker, ordered by ID. With includeConfigs each broker's configuration is
+// fetched as well, with sensitive values blanked.
+func (c *Client) DescribeCluster(includeConfigs bool) (*ClusterDescription, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return nil, err
+	}
+
+	controller, err := c.admin.Controller()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find controller: %w", err)
+	}
+	// Any metadata response from v2 on carries the cluster ID. Ask for a single
+	// known topic to keep the response small: sarama sends an empty topic list
+	// as a request for every topic.
+	var topics []string
+	if known, err := c.client.Topics(); err == nil && len(known) > 0 {
+		topics = known[:1]
+	}
+	metadata, err := controller.GetMetadata(sarama.NewMetadataRequest(c.saramaConfig.Version, topics))
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe cluster: %w", err)
+	}
+
+	desc := &ClusterDescription{
+		ControllerID: metadata.ControllerID,
+		Brokers:      make([]BrokerDescription, len(metadata.Brokers)),
+	}
+	if metadata.ClusterID != nil {
+		desc.ClusterID = *metadata.ClusterID
+	}
+	for i, b := range metadata.Brokers {
+		broker := BrokerDescription{
+			ID:         b.ID(),
+			Address:    b.Addr(),
+			Rack:       b.Rack(),
+			Controller: b.ID() == metadata.ControllerID,
+		}
+		if includeConfigs {
+			entries, err := c.admin.DescribeConfig(sarama.ConfigResource{
+				Type: sarama.BrokerResource,
+				Name: strconv.Itoa(int(b.ID())),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to describe config of broker %d: %w", b.ID(), err)
+			}
+			broker.Configs = newConfigEntries(entries)
+		}
+		desc.Brokers[i] = broker
+	}
+
+	sort.Slice(desc.Brokers, func(i, j int) bool {
+		return desc.Brokers[i].ID < desc.Brokers[j].ID
+	})
+	return desc, nil
+}
+
+// DescribeLogDirs returns the log directories of the given brokers, or of
+// every broker if none are given, with the size of each partition replica
+// they hold. A non-empty topic limits the partitions reported.
+func (c *Client) DescribeLogDirs(brokerIDs []int32, topic string) ([]LogDir, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return nil, err
+	}
+
+	known := make(map[int32]bool)
+	for _, b := range c.client.Brokers() {
+		known[b.ID()] = true
+	}
+	if len(brokerIDs) == 0 {
+		for id := range known {
+			brokerIDs = append(brokerIDs, id)
+		}
+	}
+	for _, id := range brokerIDs {
+		if !known[id] {
+			return nil, fmt.Errorf("%w: %d", ErrBrokerNotFound, id)
+		}
+	}
+
+	logDirs, err := c.admin.DescribeLogDirs(brokerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe log dirs: %w", err)
+	}
+
+	dirs := make([]LogDir, 0)
+	for brokerID, brokerDirs := range logDirs {
+		for _, d := range brokerDirs {
+			dir := LogDir{
+				BrokerID:   brokerID,
+				Path:       d.Path,
+				Partitions: make([]LogDirPartition, 0),
+			}
+			if d.ErrorCode != sarama.ErrNoError {
+				dir.Error = d.ErrorCode.Error()
+			}
+			for _, t := range d.Topics {
+				for _, p := range t.Partitions {
+					dir.Size += p.Size
+				}
+				if topic != "" && t.Topic != topic {
+					continue
+				}
+				for _, p := range t.Partitions {
+					dir.PartitionsSize += p.Size
+					dir.Partitions = append(dir.Partitions, LogDirPartition{
+						Topic:     t.Topic,
+						Partition: p.PartitionID,
+						Size:      p.Size,
+						OffsetLag: p.OffsetLag,
+						Temporary: p.IsTemporary,
+					})
+				}
+			}
+			sort.Slice(dir.Partitions, func(i, j int) bool {
+				if dir.Partitions[i].Topic != dir.Partitions[j].Topic {
+					return dir.Partitions[i].Topic < dir.Partitions[j].Topic
+				}
+				return dir.Partitions[i].Partition < dir.Partitions[j].Partition
+			})
+			dirs = append(dirs, dir)
+		}
+	}
+
+	sort.Slice(dirs, func(i, j int) bool {
+		if dirs[i].BrokerID != dirs[j].BrokerID {
+			return dirs[i].BrokerID < dirs[j].BrokerID
+		}
+		return dirs[i].Path < dirs[j].Path
+	})
+	return dirs, nil
+}
@@ -0,0 +1,224 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+)
+
+const (
+	DefaultFetchLimit = 100
+	MaxFetchLimit     = 1000
+
+	fetchTimeout = 5 * time.Second
+)
+
+var ErrOffsetOutOfRange = errors.New("offset out of range")
+
+// Message is a single Kafka record. When publishing, only Key, Value, Headers
+// and an optional TargetPartition are used.
+type Message struct {
+	Topic     string
+	Partition int32
+	Offset    int64
+	Key       []byte
+	Value     []byte
+	Headers   map[string]string
+	Timestamp time.Time
+
+	TargetPartition *int32
+}
+
+// FetchResult holds a page of messages read from a partition.
+type FetchResult struct {
+	Messages      []*Message
+	NextOffset    int64
+	HighWatermark int64
+}
+
+func newMessage(msg *sarama.ConsumerMessage) *Message {
+	headers := make(map[string]string, len(msg.Headers))
+	for _, h := range msg.Headers {
+		if h == nil {
+			continue
+		}
+		headers[string(h.Key)] = string(h.Value)
+	}
+
+	return &Message{
+		Topic:     msg.Topic,
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Key:       msg.Key,
+		Value:     msg.Value,
+		Headers:   headers,
+		Timestamp: msg.Timestamp,
+	}
+}
+
+// FetchMessages reads up to limit messages from a partition starting at offset.
+// A negative offset starts from the earliest available message.
+func (c *Client) FetchMessages(topic string, partition int32, offset int64, limit int) (*FetchResult, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = DefaultFetchLimit
+	}
+	if limit > MaxFetchLimit {
+		limit = MaxFetchLimit
+	}
+
+	oldest, err := c.client.GetOffset(topic, partition, sarama.OffsetOldest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get oldest offset: %w", wrapTopicError(topic, err))
+	}
+	newest, err := c.client.GetOffset(topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get newest offset: %w", wrapTopicError(topic, err))
+	}
+
+	if offset < 0 {
+		offset = oldest
+	}
+	if offset < oldest || offset > newest {
+		return nil, fmt.Errorf("%w: %d not in [%d, %d]", ErrOffsetOutOfRange, offset, oldest, newest)
+	}
+
+	result := &FetchResult{
+		Messages:      make([]*Message, 0),
+		NextOffset:    offset,
+		HighWatermark: newest,
+	}
+	if offset == newest {
+		return result, nil
+	}
+
+	consumer, err := sarama.NewConsumerFromClient(c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create consumer: %w", err)
+	}
+	defer consumer.Close()
+
+	pc, err := consumer.ConsumePartition(topic, partition, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to consume partition: %w", wrapTopicError(topic, err))
+	}
+	defer pc.Close()
+
+	timeout := time.NewTimer(fetchTimeout)
+	defer timeout.Stop()
+
+	for len(result.Messages) < limit {
+		select {
+		case msg := <-pc.Messages():
+			result.Messages = append(result.Messages, newMessage(msg))
+			result.NextOffset = msg.Offset + 1
+			if result.NextOffset >= newest {
+				return result, nil
+			}
+		case <-timeout.C:
+			return result, nil
+		}
+	}
+
+	return result, nil
+}
+
+// Tail streams records from every partition of a topic until ctx is done.
+// Partitions present in offsets resume from the given offset, falling back to
+// the earliest retained offset if it has expired; all others start at the
+// newest offset. It also returns the offset each partition starts at, so
+// callers can resume every partition, including those that stay quiet.
+func (c *Client) Tail(ctx context.Context, topic string, offsets map[int32]int64) (<-chan *Message, map[int32]int64, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return nil, nil, err
+	}
+
+	partitions, err := c.client.Partitions(topic)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get topic partitions: %w", wrapTopicError(topic, err))
+	}
+
+	consumer, err := sarama.NewConsumerFromClient(c.client)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create consumer: %w", err)
+	}
+
+	pcs := make([]sarama.PartitionConsumer, 0, len(partitions))
+	fail := func(partition int32, err error) (<-chan *Message, map[int32]int64, error) {
+		for _, pc := range pcs {
+			pc.Close()
+		}
+		consumer.Close()
+		return nil, nil, fmt.Errorf("failed to consume partition %d: %w", partition, err)
+	}
+
+	starts := make(map[int32]int64, len(partitions))
+	for _, partition := range partitions {
+		// Resolve the newest offset up front so it can be reported
+		offset, ok := offsets[partition]
+		if !ok {
+			offset, err = c.client.GetOffset(topic, partition, sarama.OffsetNewest)
+			if err != nil {
+				return fail(partition, err)
+			}
+		}
+
+		pc, err := consumer.ConsumePartition(topic, partition, offset)
+		if errors.Is(err, sarama.ErrOffsetOutOfRange) {
+			offset, err = c.client.GetOffset(topic, partition, sarama.OffsetOldest)
+			if err == nil {
+				pc, err = consumer.ConsumePartition(topic, partition, offset)
+			}
+		}
+		if err != nil {
+			return fail(partition, err)
+		}
+		pcs = append(pcs, pc)
+		starts[partition] = offset
+	}
+
+	out := make(chan *Message)
+	var wg sync.WaitGroup
+	for _, pc := range pcs {
+		wg.Add(1)
+		go func(pc sarama.PartitionConsumer) {
+			defer wg.Done()
+			for {
+				select {
+				case msg := <-pc.Messages():
+					select {
+					case out <- newMessage(msg):
+					case <-ctx.Done():
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(pc)
+	}
+
+	go func() {
+		wg.Wait()
+		for _, pc := range pcs {
+			pc.Close()
+		}
+		consumer.Close()
+		close(out)
+	}()
+
+	return out, starts, nil
+}
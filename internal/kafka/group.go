@@ -0,0 +1,231 @@
+package kafka
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+)
+
+// groupStateDead is reported by the coordinator for groups that do not exist.
+const groupStateDead = "Dead"
+
+var (
+	ErrGroupNotFound = errors.New("consumer group not found")
+	ErrGroupNotEmpty = errors.New("consumer group has active members")
+)
+
+// ConsumerGroupSummary identifies a consumer group known to the cluster.
+type ConsumerGroupSummary struct {
+	GroupID      string
+	ProtocolType string
+}
+
+// GroupMember is one member of a consumer group and the partitions it owns.
+type GroupMember struct {
+	MemberID   string
+	ClientID   string
+	ClientHost string
+	Assignment map[string][]int32
+}
+
+// ConsumerGroupDescription is the state and membership of a consumer group.
+type ConsumerGroupDescription struct {
+	GroupID      string
+	State        string
+	ProtocolType string
+	Protocol     string
+	Members      []GroupMember
+}
+
+// PartitionLag is the committed offset of a group on one partition and how
+// far it trails the partition's latest offset. LatestOffset and Lag are -1
+// when the latest offset could not be read.
+type PartitionLag struct {
+	Topic           string
+	Partition       int32
+	CommittedOffset int64
+	LatestOffset    int64
+	Lag             int64
+}
+
+// wrapGroupError translates coordinator errors about a group into sentinels.
+func wrapGroupError(groupID string, err error) error {
+	switch {
+	case errors.Is(err, sarama.ErrGroupIDNotFound):
+		return fmt.Errorf("%w: %s", ErrGroupNotFound, groupID)
+	case errors.Is(err, sarama.ErrNonEmptyGroup):
+		return fmt.Errorf("%w: %s", ErrGroupNotEmpty, groupID)
+	}
+	return err
+}
+
+func (c *Client) ListConsumerGroups() ([]ConsumerGroupSummary, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return nil, err
+	}
+
+	groups, err := c.admin.ListConsumerGroups()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list consumer groups: %w", err)
+	}
+
+	summaries := make([]ConsumerGroupSummary, 0, len(groups))
+	for id, protocolType := range groups {
+		summaries = append(summaries, ConsumerGroupSummary{GroupID: id, ProtocolType: protocolType})
+	}
+	sort.Slice(summaries, func(i, j int) bool { return summaries[i].GroupID < summaries[j].GroupID })
+	return summaries, nil
+}
+
+// describeGroup fetches a group description from its coordinator.
+// Callers must hold c.mu.
+func (c *Client) describeGroup(groupID string) (*sarama.GroupDescription, error) {
+	groups, err := c.admin.DescribeConsumerGroups([]string{groupID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe consumer group: %w", err)
+	}
+	if len(groups) == 0 || groups[0].State == groupStateDead {
+		return nil, fmt.Errorf("%w: %s", ErrGroupNotFound, groupID)
+	}
+	if groups[0].Err != sarama.ErrNoError {
+		return nil, fmt.Errorf("failed to describe consumer group: %w", wrapGroupError(groupID, groups[0].Err))
+	}
+	return groups[0], nil
+}
+
+// DescribeConsumerGroup returns the state of a group and the partitions
+// assigned to each of its members.
+func (c *Client) DescribeConsumerGroup(groupID string) (*ConsumerGroupDescription, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return nil, err
+	}
+
+	group, err := c.describeGroup(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := &ConsumerGroupDescription{
+		GroupID:      group.GroupId,
+		State:        group.State,
+		ProtocolType: group.ProtocolType,
+		Protocol:     group.Protocol,
+		Members:      make([]GroupMember, 0, len(group.Members)),
+	}
+	for id, m := range group.Members {
+		member := GroupMember{
+			MemberID:   id,
+			ClientID:   m.ClientId,
+			ClientHost: m.ClientHost,
+			Assignment: map[string][]int32{},
+		}
+		// Only the consumer protocol carries a decodable assignment
+		if group.ProtocolType == "consumer" && len(m.MemberAssignment) > 0 {
+			assignment, err := m.GetMemberAssignment()
+			if err != nil {
+				c.logger.Warn("Failed to decode member assignment",
+					zap.String("group", groupID),
+					zap.String("member", id),
+					zap.Error(err),
+				)
+			} else {
+				member.Assignment = assignment.Topics
+			}
+		}
+		desc.Members = append(desc.Members, member)
+	}
+	sort.Slice(desc.Members, func(i, j int) bool { return desc.Members[i].MemberID < desc.Members[j].MemberID })
+	return desc, nil
+}
+
+// ConsumerGroupLag returns the committed offset and lag of a group for every
+// partition it has committed on, optionally restricted to one topic.
+func (c *Client) ConsumerGroupLag(groupID string, topic string) ([]PartitionLag, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return nil, err
+	}
+
+	if _, err := c.describeGroup(groupID); err != nil {
+		return nil, err
+	}
+
+	// A nil partition list fetches the offsets of every topic
+	var partitions map[string][]int32
+	if topic != "" {
+		ids, err := c.client.Partitions(topic)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get topic partitions: %w", wrapTopicError(topic, err))
+		}
+		partitions = map[string][]int32{topic: ids}
+	}
+
+	resp, err := c.admin.ListConsumerGroupOffsets(groupID, partitions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch consumer group offsets: %w", err)
+	}
+	if resp.Err != sarama.ErrNoError {
+		return nil, fmt.Errorf("failed to fetch consumer group offsets: %w", wrapGroupError(groupID, resp.Err))
+	}
+
+	lags := make([]PartitionLag, 0)
+	for t, blocks := range resp.Blocks {
+		for partition, block := range blocks {
+			// -1 means the group has never committed on this partition
+			if block.Err != sarama.ErrNoError || block.Offset < 0 {
+				continue
+			}
+			lag := PartitionLag{
+				Topic:           t,
+				Partition:       partition,
+				CommittedOffset: block.Offset,
+				LatestOffset:    -1,
+				Lag:             -1,
+			}
+			if latest, err := c.client.GetOffset(t, partition, sarama.OffsetNewest); err == nil {
+				lag.LatestOffset = latest
+				lag.Lag = latest - block.Offset
+				if lag.Lag < 0 {
+					lag.Lag = 0
+				}
+			}
+			lags = append(lags, lag)
+		}
+	}
+	sort.Slice(lags, func(i, j int) bool {
+		if lags[i].Topic != lags[j].Topic {
+			return lags[i].Topic < lags[j].Topic
+		}
+		return lags[i].Partition < lags[j].Partition
+	})
+	return lags, nil
+}
+
+// DeleteConsumerGroup removes a group and its committed offsets. The
+// coordinator refuses while the group has active members.
+func (c *Client) DeleteConsumerGroup(groupID string) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return err
+	}
+
+	if err := c.admin.DeleteConsumerGroup(groupID); err != nil {
+		return fmt.Errorf("failed to delete consumer group: %w", wrapGroupError(groupID, err))
+	}
+
+	c.logger.Info("Consumer group deleted", zap.String("group", groupID))
+	return nil
+}
@@ -0,0 +1,103 @@
+package kafka
+
+import (
+	"fmt"
+	"time"
+)
+
+// ProbeTimeout bounds how long health checks wait for a cluster, so that an
+// unreachable cluster cannot hold a response past the default Kubernetes
+// probe timeout of one second.
+const ProbeTimeout = 800 * time.Millisecond
+
+// ClusterHealth is the outcome of a broker connectivity probe.
+type ClusterHealth struct {
+	ControllerID int32
+	BrokerCount  int
+	// LastSuccess is the time of the most recent successful probe, and is
+	// zero if no probe has succeeded yet.
+	LastSuccess time.Time
+}
+
+// Connected reports whether the initial connection to the cluster has been made.
+func (c *Client) Connected() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.available() == nil
+}
+
+// Probe checks that brokers are reachable by fetching cluster metadata through
+// the admin client. LastSuccess is set on the returned health even on error.
+func (c *Client) Probe() (*ClusterHealth, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	health := &ClusterHealth{ControllerID: -1}
+	if err := c.available(); err != nil {
+		health.LastSuccess = c.lastProbe()
+		return health, err
+	}
+
+	brokers, controllerID, err := c.admin.DescribeCluster()
+	if err != nil {
+		health.LastSuccess = c.lastProbe()
+		return health, fmt.Errorf("%w: failed to describe cluster: %v", ErrUnavailable, err)
+	}
+	if len(brokers) == 0 {
+		health.LastSuccess = c.lastProbe()
+		return health, fmt.Errorf("%w: no brokers in cluster metadata", ErrUnavailable)
+	}
+
+	now := time.Now()
+	c.probeMu.Lock()
+	c.lastProbeSuccess = now
+	c.probeMu.Unlock()
+
+	health.ControllerID = controllerID
+	health.BrokerCount = len(brokers)
+	health.LastSuccess = now
+	return health, nil
+}
+
+// probeCall is a probe in flight, shared by every caller waiting for it.
+type probeCall struct {
+	done   chan struct{}
+	health *ClusterHealth
+	err    error
+}
+
+// ProbeWithin runs Probe but gives up waiting after timeout, reporting the
+// cluster as unavailable. A timed-out probe keeps running, and later callers
+// wait for it instead of starting another one.
+func (c *Client) ProbeWithin(timeout time.Duration) (*ClusterHealth, error) {
+	c.probeMu.Lock()
+	call := c.probing
+	if call == nil {
+		call = &probeCall{done: make(chan struct{})}
+		c.probing = call
+		go func() {
+			call.health, call.err = c.Probe()
+			c.probeMu.Lock()
+			c.probing = nil
+			c.probeMu.Unlock()
+			close(call.done)
+		}()
+	}
+	c.probeMu.Unlock()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-call.done:
+		return call.health, call.err
+	case <-timer.C:
+		health := &ClusterHealth{ControllerID: -1, LastSuccess: c.lastProbe()}
+		return health, fmt.Errorf("%w: probe timed out after %s", ErrUnavailable, timeout)
+	}
+}
+
+func (c *Client) lastProbe() time.Time {
+	c.probeMu.Lock()
+	defer c.probeMu.Unlock()
+	return c.lastProbeSuccess
+}
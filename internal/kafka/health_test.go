@@ -0,0 +1,48 @@
+package kafka
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestProbeWithinTimesOut(t *testing.T) {
+	c := &Client{}
+	// A probe still waiting on an unreachable cluster
+	c.probing = &probeCall{done: make(chan struct{})}
+	c.lastProbeSuccess = time.Unix(1700000000, 0)
+
+	start := time.Now()
+	health, err := c.ProbeWithin(20 * time.Millisecond)
+	if !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("ProbeWithin() error = %v, want %v", err, ErrUnavailable)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("ProbeWithin() took %s", elapsed)
+	}
+	if !health.LastSuccess.Equal(c.lastProbeSuccess) {
+		t.Fatalf("LastSuccess = %s, want %s", health.LastSuccess, c.lastProbeSuccess)
+	}
+}
+
+func TestProbeWithinSharesProbe(t *testing.T) {
+	c := &Client{}
+	call := &probeCall{done: make(chan struct{}), err: ErrUnavailable}
+	c.probing = call
+	close(call.done)
+
+	// The probe in flight answers instead of a new one
+	if _, err := c.ProbeWithin(time.Second); err != call.err {
+		t.Fatalf("ProbeWithin() error = %v, want the shared probe's %v", err, call.err)
+	}
+}
+
+func TestProbeWithinUnconnected(t *testing.T) {
+	c := &Client{}
+	if _, err := c.ProbeWithin(time.Second); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("ProbeWithin() error = %v, want %v", err, ErrUnavailable)
+	}
+	if c.probing != nil {
+		t.Fatal("finished probe is still in flight")
+	}
+}
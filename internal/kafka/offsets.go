@@ -0,0 +1,300 @@
+package kafka
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+)
+
+// Offset reset strategies accepted by ResetConsumerGroupOffsets.
+const (
+	ResetEarliest  = "earliest"
+	ResetLatest    = "latest"
+	ResetTimestamp = "timestamp"
+	ResetShift     = "shift"
+	ResetExplicit  = "explicit"
+)
+
+var ErrInvalidOffsetReset = errors.New("invalid offset reset")
+
+// OffsetReset describes how to move a consumer group's committed offsets on a
+// topic. Timestamp is used by the timestamp strategy, Shift (negative to
+// rewind) by shift, and Offsets by explicit, which also selects the
+// partitions. Other strategies apply to Partitions, or every partition if empty.
+type OffsetReset struct {
+	Strategy   string
+	Timestamp  time.Time
+	Shift      int64
+	Offsets    map[int32]int64
+	Partitions []int32
+	DryRun     bool
+}
+
+// PlannedOffset is the committed offset of a partition before and after a
+// reset. CurrentOffset is -1 if the group had not committed on it.
+type PlannedOffset struct {
+	Partition     int32
+	CurrentOffset int64
+	NewOffset     int64
+}
+
+// offsetForTime returns the earliest offset whose timestamp is at or after t,
+// or the latest offset with latest set if every record is older. Callers
+// must hold c.mu.
+func (c *Client) offsetForTime(topic string, partition int32, t time.Time) (offset int64, latest bool, err error) {
+	// Negative timestamps would collide with the OffsetNewest and OffsetOldest sentinels
+	ms := t.UnixMilli()
+	if ms < 0 {
+		ms = 0
+	}
+
+	offset, err = c.client.GetOffset(topic, partition, ms)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get offset for time on partition %d: %w", partition, err)
+	}
+	if offset >= 0 {
+		return offset, false, nil
+	}
+
+	offset, err = c.client.GetOffset(topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get newest offset: %w", err)
+	}
+	return offset, true, nil
+}
+
+// TimestampOffset is the replay start of a partition for a point in time.
+// Latest is set when every record is older, in which case Offset is the
+// partition's latest offset.
+type TimestampOffset struct {
+	Partition int32
+	Offset    int64
+	Latest    bool
+}
+
+// OffsetsForTimestamp returns, for every partition of topic, the earliest
+// offset whose record timestamp is at or after t.
+func (c *Client) OffsetsForTimestamp(topic string, t time.Time) ([]TimestampOffset, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return nil, err
+	}
+
+	partitions, err := c.client.Partitions(topic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get topic partitions: %w", wrapTopicError(topic, err))
+	}
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i] < partitions[j] })
+
+	offsets := make([]TimestampOffset, len(partitions))
+	for i, partition := range partitions {
+		offset, latest, err := c.offsetForTime(topic, partition, t)
+		if err != nil {
+			return nil, err
+		}
+		offsets[i] = TimestampOffset{
+			Partition: partition,
+			Offset:    offset,
+			Latest:    latest,
+		}
+	}
+	return offsets, nil
+}
+
+// ResetConsumerGroupOffsets moves the committed offsets of groupID on topic
+// according to reset and returns the plan. The group must have no active
+// members, since they would overwrite the new offsets. With DryRun the plan
+// is returned without committing anything.
+func (c *Client) ResetConsumerGroupOffsets(groupID string, topic string, reset OffsetReset) ([]PlannedOffset, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return nil, err
+	}
+
+	groups, err := c.admin.DescribeConsumerGroups([]string{groupID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe consumer group: %w", err)
+	}
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("failed to describe consumer group: no description of %s returned", groupID)
+	}
+	// Without a description the members are unknown, so the group could be
+	// active. Offsets of a group that does not exist yet can be set.
+	if groups[0].Err != sarama.ErrNoError && groups[0].Err != sarama.ErrGroupIDNotFound {
+		return nil, fmt.Errorf("failed to describe consumer group: %w", wrapGroupError(groupID, groups[0].Err))
+	}
+	if len(groups[0].Members) > 0 {
+		return nil, fmt.Errorf("%w: %s has %d members, stop them before resetting offsets", ErrGroupNotEmpty, groupID, len(groups[0].Members))
+	}
+
+	partitions, err := c.resetPartitions(topic, reset)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := c.admin.ListConsumerGroupOffsets(groupID, map[string][]int32{topic: partitions})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch consumer group offsets: %w", err)
+	}
+
+	plan := make([]PlannedOffset, len(partitions))
+	for i, partition := range partitions {
+		planned := PlannedOffset{Partition: partition, CurrentOffset: -1}
+		if block := current.GetBlock(topic, partition); block != nil && block.Err == sarama.ErrNoError {
+			planned.CurrentOffset = block.Offset
+		}
+
+		planned.NewOffset, err = c.resetOffset(topic, partition, planned.CurrentOffset, reset)
+		if err != nil {
+			return nil, err
+		}
+		plan[i] = planned
+	}
+
+	if reset.DryRun {
+		return plan, nil
+	}
+
+	if err := c.commitOffsets(groupID, topic, plan); err != nil {
+		return nil, err
+	}
+
+	c.logger.Info("Consumer group offsets reset",
+		zap.String("group", groupID),
+		zap.String("topic", topic),
+		zap.String("strategy", reset.Strategy),
+		zap.Int("partitions", len(plan)),
+	)
+	return plan, nil
+}
+
+// resetPartitions resolves and validates the partitions a reset applies to.
+func (c *Client) resetPartitions(topic string, reset OffsetReset) ([]int32, error) {
+	all, err := c.client.Partitions(topic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get topic partitions: %w", wrapTopicError(topic, err))
+	}
+
+	requested := reset.Partitions
+	if reset.Strategy == ResetExplicit {
+		if len(reset.Offsets) == 0 {
+			return nil, fmt.Errorf("%w: offsets are required for the explicit strategy", ErrInvalidOffsetReset)
+		}
+		requested = make([]int32, 0, len(reset.Offsets))
+		for partition := range reset.Offsets {
+			requested = append(requested, partition)
+		}
+	}
+	if len(requested) == 0 {
+		return all, nil
+	}
+
+	exists := make(map[int32]bool, len(all))
+	for _, partition := range all {
+		exists[partition] = true
+	}
+	partitions := make([]int32, 0, len(requested))
+	seen := make(map[int32]bool, len(requested))
+	for _, partition := range requested {
+		if !exists[partition] {
+			return nil, fmt.Errorf("%w: %d", ErrInvalidPartition, partition)
+		}
+		if !seen[partition] {
+			seen[partition] = true
+			partitions = append(partitions, partition)
+		}
+	}
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i] < partitions[j] })
+	return partitions, nil
+}
+
+// resetOffset computes the new offset of one partition, clamped to the
+// retained range except for explicit offsets, which must already be in it.
+func (c *Client) resetOffset(topic string, partition int32, current int64, reset OffsetReset) (int64, error) {
+	oldest, err := c.client.GetOffset(topic, partition, sarama.OffsetOldest)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get oldest offset: %w", err)
+	}
+	newest, err := c.client.GetOffset(topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get newest offset: %w", err)
+	}
+
+	var offset int64
+	switch reset.Strategy {
+	case ResetEarliest:
+		offset = oldest
+	case ResetLatest:
+		offset = newest
+	case ResetTimestamp:
+		if reset.Timestamp.IsZero() {
+			return 0, fmt.Errorf("%w: timestamp is required for the timestamp strategy", ErrInvalidOffsetReset)
+		}
+		offset, _, err = c.offsetForTime(topic, partition, reset.Timestamp)
+		if err != nil {
+			return 0, err
+		}
+	case ResetShift:
+		if current < 0 {
+			return 0, fmt.Errorf("%w: partition %d has no committed offset to shift", ErrInvalidOffsetReset, partition)
+		}
+		offset = current + reset.Shift
+	case ResetExplicit:
+		offset = reset.Offsets[partition]
+		if offset < oldest || offset > newest {
+			return 0, fmt.Errorf("%w: %d not in [%d, %d] on partition %d", ErrOffsetOutOfRange, offset, oldest, newest, partition)
+		}
+	default:
+		return 0, fmt.Errorf("%w: unknown strategy %q", ErrInvalidOffsetReset, reset.Strategy)
+	}
+
+	if offset < oldest {
+		offset = oldest
+	}
+	if offset > newest {
+		offset = newest
+	}
+	return offset, nil
+}
+
+// commitOffsets commits the planned offsets outside of any group generation,
+// which the coordinator only accepts while the group is empty.
+func (c *Client) commitOffsets(groupID string, topic string, plan []PlannedOffset) error {
+	coordinator, err := c.client.Coordinator(groupID)
+	if err != nil {
+		return fmt.Errorf("failed to find group coordinator: %w", err)
+	}
+
+	req := &sarama.OffsetCommitRequest{
+		Version:                 2,
+		ConsumerGroup:           groupID,
+		ConsumerGroupGeneration: -1,
+		RetentionTime:           -1,
+	}
+	for _, p := range plan {
+		req.AddBlock(topic, p.Partition, p.NewOffset, 0, 0, "")
+	}
+
+	resp, err := coordinator.CommitOffset(req)
+	if err != nil {
+		return fmt.Errorf("failed to commit offsets: %w", err)
+	}
+	for partition, kerr := range resp.Errors[topic] {
+		switch {
+		case kerr == sarama.ErrNoError:
+		case errors.Is(kerr, sarama.ErrUnknownMemberId), errors.Is(kerr, sarama.ErrIllegalGeneration):
+			return fmt.Errorf("%w: %s gained members during the reset", ErrGroupNotEmpty, groupID)
+		default:
+			return fmt.Errorf("failed to commit offset for partition %d: %w", partition, kerr)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package kafka
+
+import (
+	"errors"
+	"fmt"
+	"kafka-gateway/internal/config"
+
+	"github.com/Shopify/sarama"
+)
+
+// Partitioner strategies accepted in kafka.partitioner and kafka.topics[].partitioner.
+const (
+	PartitionerHash       = "hash"
+	PartitionerRandom     = "random"
+	PartitionerRoundRobin = "round-robin"
+	PartitionerManual     = "manual"
+)
+
+var (
+	ErrPartitionRequired = errors.New("topic uses the manual partitioner, an explicit partition is required")
+	ErrInvalidPartition  = errors.New("partition does not exist")
+)
+
+// explicitPartition marks a producer message whose partition was chosen by the caller.
+type explicitPartition struct{}
+
+func validPartitioner(strategy string) bool {
+	switch strategy {
+	case PartitionerHash, PartitionerRandom, PartitionerRoundRobin, PartitionerManual:
+		return true
+	}
+	return false
+}
+
+// newPartitionerConstructor resolves the configured strategy for each topic.
+// Messages with an explicit partition bypass the strategy entirely.
+func newPartitionerConstructor(cfg config.KafkaConfig) (sarama.PartitionerConstructor, error) {
+	defaultStrategy := cfg.Partitioner
+	if defaultStrategy == "" {
+		defaultStrategy = PartitionerHash
+	}
+	if !validPartitioner(defaultStrategy) {
+		return nil, fmt.Errorf("unknown partitioner %q", defaultStrategy)
+	}
+
+	strategies := make(map[string]string, len(cfg.Topics))
+	for _, topic := range cfg.Topics {
+		if topic.Partitioner == "" {
+			continue
+		}
+		if !validPartitioner(topic.Partitioner) {
+			return nil, fmt.Errorf("unknown partitioner %q for topic %s", topic.Partitioner, topic.Name)
+		}
+		strategies[topic.Name] = topic.Partitioner
+	}
+
+	return func(topic string) sarama.Partitioner {
+		strategy, ok := strategies[topic]
+		if !ok {
+			strategy = defaultStrategy
+		}
+
+		var fallback sarama.Partitioner
+		switch strategy {
+		case PartitionerRandom:
+			fallback = sarama.NewRandomPartitioner(topic)
+		case PartitionerRoundRobin:
+			fallback = sarama.NewRoundRobinPartitioner(topic)
+		case PartitionerManual:
+			fallback = manualPartitioner{}
+		default:
+			fallback = &murmur2Partitioner{random: sarama.NewRandomPartitioner(topic)}
+		}
+		return &explicitPartitioner{fallback: fallback}
+	}, nil
+}
+
+// explicitPartitioner honours a caller-chosen partition and otherwise
+// delegates to the topic's configured strategy.
+type explicitPartitioner struct {
+	fallback sarama.Partitioner
+}
+
+func (p *explicitPartitioner) Partition(msg *sarama.ProducerMessage, numPartitions int32) (int32, error) {
+	if _, ok := msg.Metadata.(explicitPartition); ok {
+		if msg.Partition < 0 || msg.Partition >= numPartitions {
+			return -1, fmt.Errorf("%w: %d", ErrInvalidPartition, msg.Partition)
+		}
+		return msg.Partition, nil
+	}
+	return p.fallback.Partition(msg, numPartitions)
+}
+
+func (p *explicitPartitioner) RequiresConsistency() bool {
+	return p.fallback.RequiresConsistency()
+}
+
+// MessageRequiresConsistency implements sarama.DynamicConsistencyPartitioner.
+// Explicit partitions always index the full partition list.
+func (p *explicitPartitioner) MessageRequiresConsistency(msg *sarama.ProducerMessage) bool {
+	if _, ok := msg.Metadata.(explicitPartition); ok {
+		return true
+	}
+	if dynamic, ok := p.fallback.(sarama.DynamicConsistencyPartitioner); ok {
+		return dynamic.MessageRequiresConsistency(msg)
+	}
+	return p.fallback.RequiresConsistency()
+}
+
+type manualPartitioner struct{}
+
+func (manualPartitioner) Partition(*sarama.ProducerMessage, int32) (int32, error) {
+	return -1, ErrPartitionRequired
+}
+
+func (manualPartitioner) RequiresConsistency() bool {
+	return true
+}
+
+// murmur2Partitioner places keyed messages on the same partitions as the Java
+// client's default partitioner. Messages without a key are spread randomly.
+type murmur2Partitioner struct {
+	random sarama.Partitioner
+}
+
+func (p *murmur2Partitioner) Partition(msg *sarama.ProducerMessage, numPartitions int32) (int32, error) {
+	if msg.Key == nil {
+		return p.random.Partition(msg, numPartitions)
+	}
+	key, err := msg.Key.Encode()
+	if err != nil {
+		return -1, err
+	}
+	return int32((murmur2(key) & 0x7fffffff) % uint32(numPartitions)), nil
+}
+
+func (p *murmur2Partitioner) RequiresConsistency() bool {
+	return true
+}
+
+func (p *murmur2Partitioner) MessageRequiresConsistency(msg *sarama.ProducerMessage) bool {
+	return msg.Key != nil
+}
+
+// murmur2 is a port of org.apache.kafka.common.utils.Utils.murmur2.
+func murmur2(data []byte) uint32 {
+	const (
+		seed uint32 = 0x9747b28c
+		m    uint32 = 0x5bd1e995
+		r           = 24
+	)
+
+	length := len(data)
+	h := seed ^ uint32(length)
+
+	for i := 0; i+4 <= length; i += 4 {
+		k := uint32(data[i]) | uint32(data[i+1])<<8 | uint32(data[i+2])<<16 | uint32(data[i+3])<<24
+		k *= m
+		k ^= k >> r
+		k *= m
+		h *= m
+		h ^= k
+	}
+
+	tail := length &^ 3
+	switch length % 4 {
+	case 3:
+		h ^= uint32(data[tail+2]) << 16
+		fallthrough
+	case 2:
+		h ^= uint32(data[tail+1]) << 8
+		fallthrough
+	case 1:
+		h ^= uint32(data[tail])
+		h *= m
+	}
+
+	h ^= h >> 13
+	h *= m
+	h ^= h >> 15
+	return h
+}
@@ -0,0 +1,120 @@
+package kafka
+
+import (
+	"errors"
+	"kafka-gateway/internal/config"
+	"testing"
+
+	"github.com/Shopify/sarama"
+)
+
+// TestMurmur2 uses the vectors of the Java client's UtilsTest.testMurmur2.
+func TestMurmur2(t *testing.T) {
+	tests := []struct {
+		data string
+		want int32
+	}{
+		{data: "21", want: -973932308},
+		{data: "foobar", want: -790332482},
+		{data: "a-little-bit-long-string", want: -985981536},
+		{data: "a-little-bit-longer-string", want: -1486304829},
+		{data: "lkjh234lh9fiuh90y23oiuhsafujhadof229phr9h19h89h8", want: -58897971},
+		{data: "abc", want: 479470107},
+	}
+	for _, tt := range tests {
+		t.Run(tt.data, func(t *testing.T) {
+			if got := int32(murmur2([]byte(tt.data))); got != tt.want {
+				t.Fatalf("murmur2(%q) = %d, want %d", tt.data, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMurmur2Partitioner(t *testing.T) {
+	p := &murmur2Partitioner{random: sarama.NewRandomPartitioner("orders")}
+
+	tests := []struct {
+		key           string
+		numPartitions int32
+	}{
+		{key: "21", numPartitions: 3},
+		{key: "foobar", numPartitions: 12},
+		{key: "abc", numPartitions: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			want := int32((murmur2([]byte(tt.key)) & 0x7fffffff) % uint32(tt.numPartitions))
+			for i := 0; i < 3; i++ {
+				got, err := p.Partition(&sarama.ProducerMessage{Key: sarama.StringEncoder(tt.key)}, tt.numPartitions)
+				if err != nil {
+					t.Fatalf("Partition() error = %v", err)
+				}
+				if got != want {
+					t.Fatalf("Partition() = %d, want %d", got, want)
+				}
+			}
+		})
+	}
+
+	if !p.MessageRequiresConsistency(&sarama.ProducerMessage{Key: sarama.StringEncoder("k")}) {
+		t.Error("keyed messages must require consistency")
+	}
+	if p.MessageRequiresConsistency(&sarama.ProducerMessage{}) {
+		t.Error("messages without a key must not require consistency")
+	}
+}
+
+func TestPartitionerConstructor(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.KafkaConfig
+		wantErr bool
+	}{
+		{name: "default", cfg: config.KafkaConfig{}},
+		{name: "per topic", cfg: config.KafkaConfig{Partitioner: PartitionerRandom, Topics: []config.TopicConfig{{Name: "audit", Partitioner: PartitionerManual}}}},
+		{name: "unknown default", cfg: config.KafkaConfig{Partitioner: "sticky"}, wantErr: true},
+		{name: "unknown per topic", cfg: config.KafkaConfig{Topics: []config.TopicConfig{{Name: "audit", Partitioner: "sticky"}}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newPartitionerConstructor(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newPartitionerConstructor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExplicitPartitioner(t *testing.T) {
+	constructor, err := newPartitionerConstructor(config.KafkaConfig{
+		Topics: []config.TopicConfig{{Name: "audit", Partitioner: PartitionerManual}},
+	})
+	if err != nil {
+		t.Fatalf("newPartitionerConstructor() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		topic   string
+		msg     *sarama.ProducerMessage
+		want    int32
+		wantErr error
+	}{
+		{name: "explicit partition", topic: "orders", msg: &sarama.ProducerMessage{Partition: 2, Metadata: explicitPartition{}}, want: 2},
+		{name: "explicit partition out of range", topic: "orders", msg: &sarama.ProducerMessage{Partition: 4, Metadata: explicitPartition{}}, wantErr: ErrInvalidPartition},
+		{name: "negative explicit partition", topic: "orders", msg: &sarama.ProducerMessage{Partition: -1, Metadata: explicitPartition{}}, wantErr: ErrInvalidPartition},
+		{name: "manual with explicit partition", topic: "audit", msg: &sarama.ProducerMessage{Partition: 1, Metadata: explicitPartition{}}, want: 1},
+		{name: "manual without partition", topic: "audit", msg: &sarama.ProducerMessage{}, wantErr: ErrPartitionRequired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := constructor(tt.topic).Partition(tt.msg, 4)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Partition() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Fatalf("Partition() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package kafka
+
+import (
+	"errors"
+	"fmt"
+	"kafka-gateway/internal/config"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var ErrClusterNotFound = errors.New("cluster not found")
+
+// clusterNamePattern keeps cluster names usable as URL path segments and
+// header values.
+var clusterNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// Registry holds one Client per configured cluster.
+type Registry struct {
+	clients     map[string]*Client
+	defaultName string
+}
+
+// NewRegistry creates a client for every cluster in cfg. Like NewClient, it
+// only fails on invalid configuration; clusters connect in the background.
+func NewRegistry(cfg *config.Config, logger *zap.Logger) (*Registry, error) {
+	clusters, err := cfg.KafkaClusters()
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Registry{
+		clients:     make(map[string]*Client, len(clusters)),
+		defaultName: cfg.DefaultCluster,
+	}
+	for name, clusterCfg := range clusters {
+		if !clusterNamePattern.MatchString(name) {
+			r.Close()
+			return nil, fmt.Errorf("invalid cluster name %q: use lowercase letters, digits, '-' and '_'", name)
+		}
+		client, err := NewClient(clusterCfg, logger.With(zap.String("cluster", name)))
+		if err != nil {
+			r.Close()
+			return nil, fmt.Errorf("cluster %s: %w", name, err)
+		}
+		r.clients[name] = client
+	}
+	return r, nil
+}
+
+// Client returns the client of the named cluster, or of the default cluster
+// if name is empty.
+func (r *Registry) Client(name string) (*Client, error) {
+	if name == "" {
+		name = r.defaultName
+	}
+	client, ok := r.clients[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrClusterNotFound, name)
+	}
+	return client, nil
+}
+
+// Default returns the client of the default cluster.
+func (r *Registry) Default() *Client {
+	return r.clients[r.defaultName]
+}
+
+// DefaultName returns the name of the default cluster.
+func (r *Registry) DefaultName() string {
+	return r.defaultName
+}
+
+// Names returns the names of all clusters in order.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.clients))
+	for name := range r.clients {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ProbeResult is the outcome of probing one cluster.
+type ProbeResult struct {
+	Health *ClusterHealth
+	Err    error
+}
+
+// ProbeAll probes every cluster concurrently, waiting at most timeout for each.
+func (r *Registry) ProbeAll(timeout time.Duration) map[string]ProbeResult {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]ProbeResult, len(r.clients))
+	)
+	for name, client := range r.clients {
+		wg.Add(1)
+		go func(name string, client *Client) {
+			defer wg.Done()
+			health, err := client.ProbeWithin(timeout)
+			mu.Lock()
+			results[name] = ProbeResult{Health: health, Err: err}
+			mu.Unlock()
+		}(name, client)
+	}
+	wg.Wait()
+	return results
+}
+
+// Close closes every client.
+func (r *Registry) Close() error {
+	var errs []error
+	for _, client := range r.clients {
+		if err := client.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,26 @@
+package kafka
+
+import "testing"
+
+func TestClusterNamePattern(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{name: "default", want: true},
+		{name: "dr-eu_1", want: true},
+		{name: "0", want: true},
+		{name: "", want: false},
+		{name: "-dr", want: false},
+		{name: "DR", want: false},
+		{name: "dr/eu", want: false},
+		{name: "dr eu", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := clusterNamePattern.MatchString(tt.name); got != tt.want {
+				t.Fatalf("clusterNamePattern.MatchString(%q) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
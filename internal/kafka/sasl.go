@@ -0,0 +1,166 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"kafka-gateway/internal/config"
+	"strings"
+	"sync"
+
+	"github.com/Shopify/sarama"
+	"github.com/xdg-go/scram"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// SASL mechanisms accepted in kafka.sasl_mechanism.
+const (
+	SASLMechanismPlain       = "PLAIN"
+	SASLMechanismSCRAMSHA256 = "SCRAM-SHA-256"
+	SASLMechanismSCRAMSHA512 = "SCRAM-SHA-512"
+	SASLMechanismOAuthBearer = "OAUTHBEARER"
+)
+
+// TokenProviderFactory builds an OAUTHBEARER token provider from configuration.
+type TokenProviderFactory func(cfg *config.KafkaOAuthConfig) (sarama.AccessTokenProvider, error)
+
+var (
+	tokenProvidersMu sync.RWMutex
+	tokenProviders   = map[string]TokenProviderFactory{
+		"client_credentials": newClientCredentialsTokenProvider,
+		"static":             newStaticTokenProvider,
+	}
+)
+
+// RegisterTokenProvider makes a token provider selectable through
+// kafka.oauth.provider. It must be called before NewClient.
+func RegisterTokenProvider(name string, factory TokenProviderFactory) {
+	tokenProvidersMu.Lock()
+	defer tokenProvidersMu.Unlock()
+	tokenProviders[name] = factory
+}
+
+func configureSASL(saramaConfig *sarama.Config, cfg config.KafkaConfig) error {
+	saramaConfig.Net.SASL.Enable = true
+	saramaConfig.Net.SASL.Handshake = true
+
+	mechanism := strings.ToUpper(cfg.SASLMechanism)
+	switch mechanism {
+	case SASLMechanismPlain, SASLMechanismSCRAMSHA256, SASLMechanismSCRAMSHA512:
+		if cfg.SASLUsername == "" || cfg.SASLPassword == "" {
+			return fmt.Errorf("sasl_username and sasl_password are required for %s", mechanism)
+		}
+		saramaConfig.Net.SASL.User = cfg.SASLUsername
+		saramaConfig.Net.SASL.Password = cfg.SASLPassword
+	}
+
+	switch mechanism {
+	case SASLMechanismPlain:
+		saramaConfig.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+	case SASLMechanismSCRAMSHA256:
+		saramaConfig.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
+		saramaConfig.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+			return &scramClient{HashGeneratorFcn: scram.SHA256}
+		}
+	case SASLMechanismSCRAMSHA512:
+		saramaConfig.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+		saramaConfig.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+			return &scramClient{HashGeneratorFcn: scram.SHA512}
+		}
+	case SASLMechanismOAuthBearer:
+		provider, err := newTokenProvider(cfg.OAuth)
+		if err != nil {
+			return err
+		}
+		saramaConfig.Net.SASL.Mechanism = sarama.SASLTypeOAuth
+		saramaConfig.Net.SASL.TokenProvider = provider
+	default:
+		return fmt.Errorf("unsupported SASL mechanism %q", cfg.SASLMechanism)
+	}
+
+	return nil
+}
+
+func newTokenProvider(cfg *config.KafkaOAuthConfig) (sarama.AccessTokenProvider, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("oauth config is required for %s", SASLMechanismOAuthBearer)
+	}
+
+	name := cfg.Provider
+	if name == "" {
+		name = "client_credentials"
+	}
+
+	tokenProvidersMu.RLock()
+	factory, ok := tokenProviders[name]
+	tokenProvidersMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown oauth token provider %q", name)
+	}
+
+	provider, err := factory(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create oauth token provider: %w", err)
+	}
+	return provider, nil
+}
+
+// oauthTokenProvider adapts an oauth2.TokenSource, which caches the token and
+// refreshes it before expiry, to sarama's AccessTokenProvider.
+type oauthTokenProvider struct {
+	source oauth2.TokenSource
+}
+
+func (p *oauthTokenProvider) Token() (*sarama.AccessToken, error) {
+	token, err := p.source.Token()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch oauth token: %w", err)
+	}
+	return &sarama.AccessToken{Token: token.AccessToken}, nil
+}
+
+func newClientCredentialsTokenProvider(cfg *config.KafkaOAuthConfig) (sarama.AccessTokenProvider, error) {
+	if cfg.TokenURL == "" || cfg.ClientID == "" {
+		return nil, fmt.Errorf("token_url and client_id are required for the client_credentials provider")
+	}
+
+	cc := &clientcredentials.Config{
+		ClientID:     cfg.ClientID,
+		ClientSecret: cfg.ClientSecret,
+		TokenURL:     cfg.TokenURL,
+		Scopes:       cfg.Scopes,
+	}
+	return &oauthTokenProvider{source: cc.TokenSource(context.Background())}, nil
+}
+
+func newStaticTokenProvider(cfg *config.KafkaOAuthConfig) (sarama.AccessTokenProvider, error) {
+	if cfg.Token == "" {
+		return nil, fmt.Errorf("token is required for the static provider")
+	}
+	return &oauthTokenProvider{source: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: cfg.Token})}, nil
+}
+
+// scramClient implements sarama.SCRAMClient on top of xdg-go/scram.
+type scramClient struct {
+	*scram.Client
+	*scram.ClientConversation
+	scram.HashGeneratorFcn
+}
+
+func (x *scramClient) Begin(userName, password, authzID string) error {
+	client, err := x.HashGeneratorFcn.NewClient(userName, password, authzID)
+	if err != nil {
+		return err
+	}
+	x.Client = client
+	x.ClientConversation = client.NewConversation()
+	return nil
+}
+
+func (x *scramClient) Step(challenge string) (string, error) {
+	return x.ClientConversation.Step(challenge)
+}
+
+func (x *scramClient) Done() bool {
+	return x.ClientConversation.Done()
+}
@@ -0,0 +1,223 @@
+package kafka
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Shopify/sarama"
+)
+
+var (
+	ErrSubscriptionNotFound = errors.New("subscription not found")
+	ErrPartitionNotAssigned = errors.New("partition is not assigned to this subscription")
+)
+
+// Subscription is a consumer group member that delivers records to a single
+// caller and commits offsets as the caller acknowledges them.
+type Subscription struct {
+	ID      string
+	GroupID string
+	Topics  []string
+
+	client   *Client
+	group    sarama.ConsumerGroup
+	messages chan *Message
+	cancel   context.CancelFunc
+	done     chan struct{}
+	err      error
+
+	mu      sync.Mutex
+	session sarama.ConsumerGroupSession
+}
+
+func newSubscriptionID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Subscribe joins the consumer group and starts delivering records from the
+// given topics. An empty groupID falls back to the configured consumer group.
+func (c *Client) Subscribe(groupID string, topics []string, fromBeginning bool) (*Subscription, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return nil, err
+	}
+
+	if len(topics) == 0 {
+		return nil, fmt.Errorf("at least one topic is required")
+	}
+	if groupID == "" {
+		groupID = c.config.ConsumerGroup
+	}
+
+	id, err := newSubscriptionID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate subscription id: %w", err)
+	}
+
+	groupConfig := *c.saramaConfig
+	if fromBeginning {
+		groupConfig.Consumer.Offsets.Initial = sarama.OffsetOldest
+	}
+
+	group, err := sarama.NewConsumerGroup(c.config.Brokers, groupID, &groupConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join consumer group: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sub := &Subscription{
+		ID:       id,
+		GroupID:  groupID,
+		Topics:   topics,
+		client:   c,
+		group:    group,
+		messages: make(chan *Message),
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+
+	c.subsMu.Lock()
+	c.subscriptions[id] = sub
+	c.subsMu.Unlock()
+
+	go sub.run(ctx)
+	return sub, nil
+}
+
+// Ack commits the offset following the acknowledged record for a subscription.
+func (c *Client) Ack(subscriptionID string, topic string, partition int32, offset int64) error {
+	c.subsMu.Lock()
+	sub, ok := c.subscriptions[subscriptionID]
+	c.subsMu.Unlock()
+	if !ok {
+		return ErrSubscriptionNotFound
+	}
+	return sub.Ack(topic, partition, offset)
+}
+
+func (c *Client) closeSubscriptions() {
+	c.subsMu.Lock()
+	subs := make([]*Subscription, 0, len(c.subscriptions))
+	for _, sub := range c.subscriptions {
+		subs = append(subs, sub)
+	}
+	c.subsMu.Unlock()
+
+	for _, sub := range subs {
+		sub.Close()
+	}
+}
+
+// Messages returns the channel of delivered records. It is closed when the
+// subscription stops; Err then reports why.
+func (s *Subscription) Messages() <-chan *Message {
+	return s.messages
+}
+
+func (s *Subscription) Err() error {
+	<-s.done
+	return s.err
+}
+
+// Ack marks the record at offset as processed and commits it to the group.
+// Acks for partitions that were revoked by a rebalance are rejected, since the
+// new owner will redeliver those records.
+func (s *Subscription) Ack(topic string, partition int32, offset int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.session == nil || !claimed(s.session.Claims(), topic, partition) {
+		return fmt.Errorf("%w: %s/%d", ErrPartitionNotAssigned, topic, partition)
+	}
+
+	s.session.MarkOffset(topic, partition, offset+1, "")
+	s.session.Commit()
+	return nil
+}
+
+// Close leaves the consumer group and stops delivering records.
+func (s *Subscription) Close() error {
+	s.client.subsMu.Lock()
+	delete(s.client.subscriptions, s.ID)
+	s.client.subsMu.Unlock()
+
+	s.cancel()
+	err := s.group.Close()
+	<-s.done
+	if err != nil && !errors.Is(err, sarama.ErrClosedConsumerGroup) {
+		return fmt.Errorf("failed to close consumer group: %w", err)
+	}
+	return nil
+}
+
+func (s *Subscription) run(ctx context.Context) {
+	defer close(s.done)
+	defer close(s.messages)
+
+	for {
+		// Consume returns on every rebalance, so keep rejoining until closed
+		if err := s.group.Consume(ctx, s.Topics, s); err != nil {
+			if !errors.Is(err, sarama.ErrClosedConsumerGroup) {
+				s.err = fmt.Errorf("consumer group error: %w", err)
+			}
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// Setup implements sarama.ConsumerGroupHandler.
+func (s *Subscription) Setup(session sarama.ConsumerGroupSession) error {
+	s.mu.Lock()
+	s.session = session
+	s.mu.Unlock()
+	return nil
+}
+
+// Cleanup implements sarama.ConsumerGroupHandler.
+func (s *Subscription) Cleanup(session sarama.ConsumerGroupSession) error {
+	s.mu.Lock()
+	s.session = nil
+	s.mu.Unlock()
+	return nil
+}
+
+// ConsumeClaim implements sarama.ConsumerGroupHandler.
+func (s *Subscription) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		select {
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			select {
+			case s.messages <- newMessage(msg):
+			case <-session.Context().Done():
+				return nil
+			}
+		case <-session.Context().Done():
+			return nil
+		}
+	}
+}
+
+func claimed(claims map[string][]int32, topic string, partition int32) bool {
+	for _, p := range claims[topic] {
+		if p == partition {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package kafka
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+)
+
+const (
+	reconnectMinBackoff = 1 * time.Second
+	reconnectMaxBackoff = 30 * time.Second
+)
+
+var ErrUnavailable = errors.New("kafka cluster is unavailable")
+
+// errClosed is returned by connect when the client was closed during the attempt.
+var errClosed = errors.New("client closed while connecting")
+
+// IsUnavailable reports whether err means the cluster could not be reached,
+// as opposed to a request the cluster rejected.
+func IsUnavailable(err error) bool {
+	return errors.Is(err, ErrUnavailable) ||
+		errors.Is(err, sarama.ErrOutOfBrokers) ||
+		errors.Is(err, sarama.ErrNotConnected) ||
+		errors.Is(err, sarama.ErrClosedClient)
+}
+
+// available returns ErrUnavailable until the supervisor has connected.
+// Callers must hold c.mu.
+func (c *Client) available() error {
+	if c.client == nil {
+		return ErrUnavailable
+	}
+	return nil
+}
+
+// supervise retries the initial connection with exponential backoff until it
+// succeeds or the client is closed. Once connected, sarama reconnects to
+// individual brokers on its own.
+func (c *Client) supervise() {
+	backoff := reconnectMinBackoff
+	for {
+		err := c.connect()
+		if err == nil {
+			c.logger.Info("Connected to Kafka", zap.Strings("brokers", c.config.Brokers))
+			return
+		}
+		if errors.Is(err, errClosed) {
+			return
+		}
+
+		c.logger.Warn("Failed to connect to Kafka, retrying",
+			zap.Error(err),
+			zap.Duration("backoff", backoff),
+		)
+
+		select {
+		case <-time.After(backoff):
+		case <-c.stop:
+			return
+		}
+
+		backoff *= 2
+		if backoff > reconnectMaxBackoff {
+			backoff = reconnectMaxBackoff
+		}
+	}
+}
+
+func (c *Client) connect() error {
+	// Create the client shared by the producer, the admin client, consumers
+	// and offset lookups, so that they reuse the same broker connections
+	client, err := sarama.NewClient(c.config.Brokers, c.saramaConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	// Create producer
+	producer, err := sarama.NewSyncProducerFromClient(client)
+	if err != nil {
+		client.Close()
+		return fmt.Errorf("failed to create producer: %w", err)
+	}
+
+	// Create admin client. Closing it closes the shared client.
+	admin, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		producer.Close()
+		client.Close()
+		return fmt.Errorf("failed to create admin client: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Close raced with the connection attempt
+	select {
+	case <-c.stop:
+		producer.Close()
+		admin.Close()
+		return errClosed
+	default:
+	}
+
+	c.producer = producer
+	c.admin = admin
+	c.client = client
+	return nil
+}
@@ -0,0 +1,71 @@
+package kafka
+
+import (
+	"errors"
+	"kafka-gateway/internal/config"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// newSupervisedTestClient returns a client for a mock cluster of one broker,
+// without starting its supervisor.
+func newSupervisedTestClient(t *testing.T) (*Client, *observer.ObservedLogs) {
+	t.Helper()
+
+	broker := sarama.NewMockBroker(t, 1)
+	t.Cleanup(broker.Close)
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetController(broker.BrokerID()),
+	})
+
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.Version = sarama.V2_0_0_0
+	saramaConfig.Producer.Return.Successes = true
+
+	core, logs := observer.New(zap.InfoLevel)
+	return &Client{
+		config:       &config.KafkaConfig{Brokers: []string{broker.Addr()}},
+		saramaConfig: saramaConfig,
+		logger:       zap.New(core),
+		stop:         make(chan struct{}),
+	}, logs
+}
+
+func TestConnectSharesOneClient(t *testing.T) {
+	c, _ := newSupervisedTestClient(t)
+
+	if err := c.connect(); err != nil {
+		t.Fatalf("connect() error = %v", err)
+	}
+	if c.client == nil || c.producer == nil || c.admin == nil {
+		t.Fatal("connect() left the client partly initialized")
+	}
+	if err := c.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if !c.client.Closed() {
+		t.Fatal("Close() left the shared client open")
+	}
+}
+
+func TestSuperviseStopsWhenClosedWhileConnecting(t *testing.T) {
+	c, logs := newSupervisedTestClient(t)
+	close(c.stop)
+
+	if err := c.connect(); !errors.Is(err, errClosed) {
+		t.Fatalf("connect() error = %v, want %v", err, errClosed)
+	}
+	if c.client != nil {
+		t.Fatal("connect() kept resources of a closed client")
+	}
+
+	c.supervise()
+	if n := logs.FilterMessage("Connected to Kafka").Len(); n != 0 {
+		t.Fatalf("supervise() logged a connection %d times after close", n)
+	}
+}
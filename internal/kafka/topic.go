@@ -0,0 +1,108 @@
+package kafka
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/Shopify/sarama"
+)
+
+var ErrTopicNotFound = errors.New("topic not found")
+
+// PartitionDescription is the replica state and offset range of one partition.
+// Offsets are -1 when they could not be read, e.g. while the partition is offline.
+type PartitionDescription struct {
+	ID              int32
+	Leader          int32
+	Replicas        []int32
+	ISR             []int32
+	OfflineReplicas []int32
+	EarliestOffset  int64
+	LatestOffset    int64
+	UnderReplicated bool
+}
+
+// TopicDescription is the full partition layout of a topic.
+type TopicDescription struct {
+	Name                      string
+	Internal                  bool
+	Partitions                []PartitionDescription
+	UnderReplicatedPartitions int
+}
+
+// wrapTopicError translates the broker's unknown topic error into ErrTopicNotFound.
+func wrapTopicError(topic string, err error) error {
+	if errors.Is(err, sarama.ErrUnknownTopicOrPartition) {
+		return fmt.Errorf("%w: %s", ErrTopicNotFound, topic)
+	}
+	return err
+}
+
+// describeTopic fetches metadata for a single topic from the controller.
+// Callers must hold c.mu.
+func (c *Client) describeTopic(topic string) (*sarama.TopicMetadata, error) {
+	metadata, err := c.admin.DescribeTopics([]string{topic})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get topic metadata: %w", err)
+	}
+	if len(metadata) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrTopicNotFound, topic)
+	}
+	if metadata[0].Err != sarama.ErrNoError {
+		return nil, fmt.Errorf("failed to get topic metadata: %w", wrapTopicError(topic, metadata[0].Err))
+	}
+	return metadata[0], nil
+}
+
+// DescribeTopic returns the leader, replicas, in-sync replicas and offset
+// range of every partition of topic. A partition is under-replicated when its
+// ISR is smaller than its replica set.
+func (c *Client) DescribeTopic(topic string) (*TopicDescription, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return nil, err
+	}
+
+	metadata, err := c.describeTopic(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := &TopicDescription{
+		Name:       metadata.Name,
+		Internal:   metadata.IsInternal,
+		Partitions: make([]PartitionDescription, len(metadata.Partitions)),
+	}
+	for i, p := range metadata.Partitions {
+		partition := PartitionDescription{
+			ID:              p.ID,
+			Leader:          p.Leader,
+			Replicas:        p.Replicas,
+			ISR:             p.Isr,
+			OfflineReplicas: p.OfflineReplicas,
+			EarliestOffset:  -1,
+			LatestOffset:    -1,
+			UnderReplicated: len(p.Isr) < len(p.Replicas),
+		}
+		if offset, err := c.client.GetOffset(topic, p.ID, sarama.OffsetOldest); err == nil {
+			partition.EarliestOffset = offset
+		}
+		if offset, err := c.client.GetOffset(topic, p.ID, sarama.OffsetNewest); err == nil {
+			partition.LatestOffset = offset
+		}
+
+		if partition.UnderReplicated {
+			desc.UnderReplicatedPartitions++
+		}
+		desc.Partitions[i] = partition
+	}
+
+	// Brokers do not guarantee partition order in metadata responses
+	sort.Slice(desc.Partitions, func(i, j int) bool {
+		return desc.Partitions[i].ID < desc.Partitions[j].ID
+	})
+	return desc, nil
+}
@@ -0,0 +1,119 @@
+package kafka
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+)
+
+// TruncateToLatest deletes every record of a partition when used as its
+// offset in TruncateTopic, matching Kafka's DeleteRecords convention.
+const TruncateToLatest int64 = -1
+
+var ErrInvalidTruncation = errors.New("invalid truncation")
+
+// TruncatedPartition reports the first retained offset of a partition before
+// and after TruncateTopic.
+type TruncatedPartition struct {
+	Partition              int32
+	PreviousEarliestOffset int64
+	EarliestOffset         int64
+}
+
+// TruncateTopic deletes the records of topic before the given per-partition
+// offsets, or before the first record at or after before on every partition.
+// Exactly one of offsets and before must be set. Offsets below the earliest
+// retained offset leave the partition untouched.
+func (c *Client) TruncateTopic(topic string, offsets map[int32]int64, before time.Time) ([]TruncatedPartition, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if err := c.available(); err != nil {
+		return nil, err
+	}
+
+	if len(offsets) == 0 && before.IsZero() {
+		return nil, fmt.Errorf("%w: offsets or a timestamp is required", ErrInvalidTruncation)
+	}
+	if len(offsets) > 0 && !before.IsZero() {
+		return nil, fmt.Errorf("%w: offsets and a timestamp are mutually exclusive", ErrInvalidTruncation)
+	}
+
+	partitions, err := c.client.Partitions(topic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get topic partitions: %w", wrapTopicError(topic, err))
+	}
+
+	if len(offsets) > 0 {
+		exists := make(map[int32]bool, len(partitions))
+		for _, partition := range partitions {
+			exists[partition] = true
+		}
+		partitions = make([]int32, 0, len(offsets))
+		for partition := range offsets {
+			if !exists[partition] {
+				return nil, fmt.Errorf("%w: %d", ErrInvalidPartition, partition)
+			}
+			partitions = append(partitions, partition)
+		}
+	}
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i] < partitions[j] })
+
+	result := make([]TruncatedPartition, len(partitions))
+	deletions := make(map[int32]int64, len(partitions))
+	for i, partition := range partitions {
+		oldest, err := c.client.GetOffset(topic, partition, sarama.OffsetOldest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get oldest offset: %w", err)
+		}
+		newest, err := c.client.GetOffset(topic, partition, sarama.OffsetNewest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get newest offset: %w", err)
+		}
+
+		var offset int64
+		if before.IsZero() {
+			offset = offsets[partition]
+			if offset == TruncateToLatest {
+				offset = newest
+			}
+			if offset < 0 || offset > newest {
+				return nil, fmt.Errorf("%w: %d not in [0, %d] on partition %d", ErrOffsetOutOfRange, offset, newest, partition)
+			}
+		} else {
+			offset, _, err = c.offsetForTime(topic, partition, before)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		truncated := TruncatedPartition{
+			Partition:              partition,
+			PreviousEarliestOffset: oldest,
+			EarliestOffset:         oldest,
+		}
+		if offset > oldest {
+			truncated.EarliestOffset = offset
+			deletions[partition] = offset
+		}
+		result[i] = truncated
+	}
+
+	if len(deletions) == 0 {
+		return result, nil
+	}
+
+	if err := c.admin.DeleteRecords(topic, deletions); err != nil {
+		return nil, fmt.Errorf("failed to delete records: %w", wrapTopicError(topic, err))
+	}
+
+	c.logger.Info("Topic truncated",
+		zap.String("topic", topic),
+		zap.Int("partitions", len(deletions)),
+	)
+	return result, nil
+}
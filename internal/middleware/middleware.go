@@ -1,7 +1,11 @@
 package middleware
 
 import (
+	"crypto/x509"
+	"errors"
 	"kafka-gateway/internal/config"
+	"kafka-gateway/internal/tenant"
+	"net/http"
 	"strings"
 	"time"
 
@@ -39,8 +43,8 @@ func CORS() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
-		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, DELETE")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With, X-Kafka-Cluster")
+		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, PATCH, DELETE")
 
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
@@ -51,12 +55,48 @@ func CORS() gin.HandlerFunc {
 	}
 }
 
+// RecordHeadersKey is the context key holding HTTP headers to copy into Kafka record headers
+const RecordHeadersKey = "recordHeaders"
+
+// ForwardHeaders middleware collects the configured HTTP headers so that
+// publish handlers can attach them to Kafka records
+func ForwardHeaders(names []string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		headers := make(map[string]string)
+		for _, name := range names {
+			if v := c.GetHeader(name); v != "" {
+				headers[name] = v
+			}
+		}
+		if len(headers) > 0 {
+			c.Set(RecordHeadersKey, headers)
+		}
+
+		c.Next()
+	}
+}
+
+// redactQuery masks credentials passed as query parameters, such as the
+// WebSocket access_token, so they stay out of the access log.
+func redactQuery(rawQuery string) string {
+	if !strings.Contains(rawQuery, "access_token=") {
+		return rawQuery
+	}
+	params := strings.Split(rawQuery, "&")
+	for i, param := range params {
+		if strings.HasPrefix(param, "access_token=") {
+			params[i] = "access_token=REDACTED"
+		}
+	}
+	return strings.Join(params, "&")
+}
+
 // Logger middleware using zap
 func Logger(logger *zap.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		start := time.Now()
 		path := c.Request.URL.Path
-		query := c.Request.URL.RawQuery
+		query := redactQuery(c.Request.URL.RawQuery)
 
 		c.Next()
 
@@ -78,8 +118,9 @@ func Logger(logger *zap.Logger) gin.HandlerFunc {
 	}
 }
 
-// Auth middleware for JWT authentication
-func Auth(cfg config.AuthConfig) gin.HandlerFunc {
+// Auth middleware for JWT authentication. Tenant API keys are accepted
+// alongside the secret.
+func Auth(cfg config.AuthConfig, tenants *tenant.Registry) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		if !cfg.Enabled {
 			c.Next()
@@ -93,6 +134,12 @@ func Auth(cfg config.AuthConfig) gin.HandlerFunc {
 		}
 
 		authHeader := c.GetHeader("Authorization")
+		// Browsers cannot set headers on WebSocket handshakes
+		if authHeader == "" && c.IsWebsocket() {
+			if token := c.Query("access_token"); token != "" {
+				authHeader = "Bearer " + token
+			}
+		}
 		if authHeader == "" {
 			c.JSON(401, gin.H{"error": "Authorization header is required"})
 			c.Abort()
@@ -109,7 +156,7 @@ func Auth(cfg config.AuthConfig) gin.HandlerFunc {
 		}
 
 		token := parts[1]
-		if token != cfg.Secret {
+		if token != cfg.Secret && !tenants.IsAPIKey(token) {
 			c.JSON(401, gin.H{"error": "Invalid token"})
 			c.Abort()
 			return
@@ -119,6 +166,52 @@ func Auth(cfg config.AuthConfig) gin.HandlerFunc {
 	}
 }
 
+// Tenant middleware resolves the caller's tenant from its API key or client
+// certificate, charges the request to the tenant's quota and stores the
+// tenant in the request context for handlers to scope topic names. Requests
+// from neither a tenant nor an operator are rejected.
+func Tenant(tenants *tenant.Registry) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !tenants.Enabled() {
+			c.Next()
+			return
+		}
+
+		token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if token == "" && c.IsWebsocket() {
+			token = c.Query("access_token")
+		}
+		var peerCerts []*x509.Certificate
+		if c.Request.TLS != nil {
+			peerCerts = c.Request.TLS.PeerCertificates
+		}
+
+		t, err := tenants.Resolve(token, peerCerts)
+		if errors.Is(err, tenant.ErrUnknownAPIKey) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			c.Abort()
+			return
+		}
+		if err != nil {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			c.Abort()
+			return
+		}
+		if t == nil {
+			c.Next()
+			return
+		}
+		if err := t.Allow(); err != nil {
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+			c.Abort()
+			return
+		}
+
+		c.Request = c.Request.WithContext(tenant.NewContext(c.Request.Context(), t))
+		c.Next()
+	}
+}
+
 // Metrics middleware for Prometheus metrics
 func Metrics() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"kafka-gateway/internal/config"
+	"kafka-gateway/internal/tenant"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestTenant(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tenants, err := tenant.NewRegistry([]config.TenantConfig{
+		{Name: "billing", APIKeys: []string{"billing-key"}},
+	}, config.AuthConfig{Secret: "operator-key"})
+	if err != nil {
+		t.Fatalf("NewRegistry: %v", err)
+	}
+
+	router := gin.New()
+	router.GET("/topics", Tenant(tenants), func(c *gin.Context) {
+		name := "operator"
+		if t := tenant.FromContext(c.Request.Context()); t != nil {
+			name = t.Name
+		}
+		c.String(http.StatusOK, name)
+	})
+
+	tests := []struct {
+		name          string
+		authorization string
+		wantStatus    int
+		wantBody      string
+	}{
+		{name: "tenant", authorization: "Bearer billing-key", wantStatus: http.StatusOK, wantBody: "billing"},
+		{name: "operator", authorization: "Bearer operator-key", wantStatus: http.StatusOK, wantBody: "operator"},
+		{name: "unknown token", authorization: "Bearer bogus", wantStatus: http.StatusUnauthorized},
+		{name: "no credentials", wantStatus: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/topics", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if tt.wantBody != "" && w.Body.String() != tt.wantBody {
+				t.Fatalf("body = %q, want %q", w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestRedactQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{query: "", want: ""},
+		{query: "limit=10", want: "limit=10"},
+		{query: "access_token=secret", want: "access_token=REDACTED"},
+		{query: "topic=orders&access_token=secret&limit=10", want: "topic=orders&access_token=REDACTED&limit=10"},
+		{query: "my_access_token=x", want: "my_access_token=x"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			if got := redactQuery(tt.query); got != tt.want {
+				t.Fatalf("redactQuery(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package tenant
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"kafka-gateway/internal/config"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrForbidden     = errors.New("not permitted for tenants")
+	ErrQuotaExceeded = errors.New("tenant quota exceeded")
+	ErrUnknownAPIKey = errors.New("API key matches no tenant or operator")
+	ErrUnknownCaller = errors.New("caller matches no tenant or operator")
+)
+
+// Tenant is a set of API credentials and client identities confined to the
+// topics and consumer groups under Namespace. A nil *Tenant stands for an
+// operator, or any caller while tenancy is disabled, and leaves names and
+// access unrestricted.
+type Tenant struct {
+	Name      string
+	Namespace string
+	limiter   *limiter
+}
+
+// Qualify prefixes a topic or consumer group name with the namespace.
+func (t *Tenant) Qualify(name string) string {
+	if t == nil {
+		return name
+	}
+	return t.Namespace + name
+}
+
+// Owns reports whether a qualified name lies in the namespace.
+func (t *Tenant) Owns(name string) bool {
+	return t == nil || strings.HasPrefix(name, t.Namespace)
+}
+
+// Local strips the namespace from a qualified name.
+func (t *Tenant) Local(name string) string {
+	if t == nil {
+		return name
+	}
+	return strings.TrimPrefix(name, t.Namespace)
+}
+
+// LocalNames returns the names in the namespace without their prefix.
+func (t *Tenant) LocalNames(names []string) []string {
+	if t == nil {
+		return names
+	}
+	local := make([]string, 0, len(names))
+	for _, name := range names {
+		if t.Owns(name) {
+			local = append(local, t.Local(name))
+		}
+	}
+	return local
+}
+
+// Allow takes one request from the tenant's quota.
+func (t *Tenant) Allow() error {
+	if t == nil || t.limiter.allow() {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrQuotaExceeded, t.Name)
+}
+
+// Forbid rejects operations that cannot be confined to a namespace, such as
+// ACL management. It returns nil for operators.
+func (t *Tenant) Forbid(operation string) error {
+	if t == nil {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrForbidden, operation)
+}
+
+// Registry resolves callers to tenants or to the operator.
+type Registry struct {
+	byAPIKey           map[string]*Tenant
+	byIdentity         map[string]*Tenant
+	operatorSecret     string
+	operatorIdentities map[string]bool
+}
+
+// NewRegistry validates the tenant configuration. Namespaces may not overlap,
+// so that no tenant can reach another's topics. Operators are identified by
+// the auth secret or by the common names in auth.operator_identities.
+func NewRegistry(cfgs []config.TenantConfig, auth config.AuthConfig) (*Registry, error) {
+	r := &Registry{
+		byAPIKey:           make(map[string]*Tenant),
+		byIdentity:         make(map[string]*Tenant),
+		operatorSecret:     auth.Secret,
+		operatorIdentities: make(map[string]bool),
+	}
+	for _, identity := range auth.OperatorIdentities {
+		if identity == "" {
+			return nil, fmt.Errorf("operator identities must be non-empty")
+		}
+		r.operatorIdentities[identity] = true
+	}
+
+	tenants := make([]*Tenant, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		if cfg.Name == "" {
+			return nil, fmt.Errorf("tenant name is required")
+		}
+		if len(cfg.APIKeys) == 0 && len(cfg.Identities) == 0 {
+			return nil, fmt.Errorf("tenant %s: at least one API key or identity is required", cfg.Name)
+		}
+		if cfg.Quota.RequestsPerSecond < 0 || cfg.Quota.Burst < 0 {
+			return nil, fmt.Errorf("tenant %s: quota must not be negative", cfg.Name)
+		}
+
+		t := &Tenant{
+			Name:      cfg.Name,
+			Namespace: cfg.Namespace,
+			limiter:   newLimiter(cfg.Quota),
+		}
+		if t.Namespace == "" {
+			t.Namespace = cfg.Name + "."
+		}
+		for _, other := range tenants {
+			if other.Name == t.Name {
+				return nil, fmt.Errorf("tenant %s is defined twice", t.Name)
+			}
+			if strings.HasPrefix(t.Namespace, other.Namespace) || strings.HasPrefix(other.Namespace, t.Namespace) {
+				return nil, fmt.Errorf("namespaces of tenants %s and %s overlap", other.Name, t.Name)
+			}
+		}
+		tenants = append(tenants, t)
+
+		for _, key := range cfg.APIKeys {
+			if _, ok := r.byAPIKey[key]; ok || key == "" || key == auth.Secret {
+				return nil, fmt.Errorf("tenant %s: API keys must be non-empty, unique and differ from the auth secret", t.Name)
+			}
+			r.byAPIKey[key] = t
+		}
+		for _, identity := range cfg.Identities {
+			if _, ok := r.byIdentity[identity]; ok || identity == "" || r.operatorIdentities[identity] {
+				return nil, fmt.Errorf("tenant %s: identities must be non-empty, unique and not operator identities", t.Name)
+			}
+			r.byIdentity[identity] = t
+		}
+	}
+	return r, nil
+}
+
+// Enabled reports whether any tenants are configured.
+func (r *Registry) Enabled() bool {
+	return len(r.byAPIKey) > 0 || len(r.byIdentity) > 0
+}
+
+// IsAPIKey reports whether key belongs to a tenant.
+func (r *Registry) IsAPIKey(key string) bool {
+	_, ok := r.byAPIKey[key]
+	return ok
+}
+
+// Resolve returns the tenant of an API key, or else of the common name of
+// the client certificate, and nil for an operator. An API key that is neither
+// a tenant's nor the auth secret is rejected even if the certificate would
+// match, and so is a caller without operator or tenant credentials: access
+// outside a namespace must be granted explicitly.
+func (r *Registry) Resolve(apiKey string, peerCerts []*x509.Certificate) (*Tenant, error) {
+	if apiKey != "" {
+		if t, ok := r.byAPIKey[apiKey]; ok {
+			return t, nil
+		}
+		if r.operatorSecret != "" && subtle.ConstantTimeCompare([]byte(apiKey), []byte(r.operatorSecret)) == 1 {
+			return nil, nil
+		}
+		return nil, ErrUnknownAPIKey
+	}
+	if len(peerCerts) > 0 {
+		commonName := peerCerts[0].Subject.CommonName
+		if t, ok := r.byIdentity[commonName]; ok {
+			return t, nil
+		}
+		if r.operatorIdentities[commonName] {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCaller, commonName)
+	}
+	return nil, ErrUnknownCaller
+}
+
+type contextKey struct{}
+
+// NewContext returns a context carrying t.
+func NewContext(ctx context.Context, t *Tenant) context.Context {
+	return context.WithValue(ctx, contextKey{}, t)
+}
+
+// FromContext returns the tenant stored in ctx, or nil.
+func FromContext(ctx context.Context) *Tenant {
+	t, _ := ctx.Value(contextKey{}).(*Tenant)
+	return t
+}
+
+// limiter is a token bucket refilled at the quota's request rate.
+type limiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newLimiter(quota config.QuotaConfig) *limiter {
+	if quota.RequestsPerSecond == 0 {
+		return nil
+	}
+	burst := float64(quota.Burst)
+	if burst == 0 {
+		burst = math.Max(1, math.Ceil(quota.RequestsPerSecond))
+	}
+	return &limiter{
+		rate:   quota.RequestsPerSecond,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+func (l *limiter) allow() bool {
+	if l == nil {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
@@ -0,0 +1,265 @@
+package tenant
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"kafka-gateway/internal/config"
+	"testing"
+	"time"
+)
+
+func certFor(commonName string) []*x509.Certificate {
+	return []*x509.Certificate{{Subject: pkix.Name{CommonName: commonName}}}
+}
+
+func testRegistry(t *testing.T) *Registry {
+	t.Helper()
+	r, err := NewRegistry([]config.TenantConfig{
+		{Name: "billing", APIKeys: []string{"billing-key"}, Identities: []string{"billing-service"}},
+		{Name: "search", Namespace: "team-search.", Identities: []string{"search-indexer"}},
+	}, config.AuthConfig{Secret: "operator-key", OperatorIdentities: []string{"platform-admin"}})
+	if err != nil {
+		t.Fatalf("NewRegistry: %v", err)
+	}
+	return r
+}
+
+func TestResolve(t *testing.T) {
+	r := testRegistry(t)
+
+	tests := []struct {
+		name       string
+		apiKey     string
+		peerCerts  []*x509.Certificate
+		wantTenant string
+		wantErr    error
+	}{
+		{name: "tenant API key", apiKey: "billing-key", wantTenant: "billing"},
+		{name: "tenant API key wins over certificate", apiKey: "billing-key", peerCerts: certFor("search-indexer"), wantTenant: "billing"},
+		{name: "tenant identity", peerCerts: certFor("search-indexer"), wantTenant: "search"},
+		{name: "operator secret", apiKey: "operator-key"},
+		{name: "operator secret wins over tenant certificate", apiKey: "operator-key", peerCerts: certFor("billing-service")},
+		{name: "operator identity", peerCerts: certFor("platform-admin")},
+		{name: "unknown API key", apiKey: "bogus", wantErr: ErrUnknownAPIKey},
+		{name: "unknown API key with tenant certificate", apiKey: "bogus", peerCerts: certFor("billing-service"), wantErr: ErrUnknownAPIKey},
+		{name: "unknown API key with operator certificate", apiKey: "bogus", peerCerts: certFor("platform-admin"), wantErr: ErrUnknownAPIKey},
+		{name: "unknown identity", peerCerts: certFor("someone"), wantErr: ErrUnknownCaller},
+		{name: "no credentials", wantErr: ErrUnknownCaller},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.Resolve(tt.apiKey, tt.peerCerts)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Resolve() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if tt.wantTenant == "" {
+				if got != nil {
+					t.Fatalf("Resolve() = tenant %s, want operator", got.Name)
+				}
+				return
+			}
+			if got == nil || got.Name != tt.wantTenant {
+				t.Fatalf("Resolve() = %v, want tenant %s", got, tt.wantTenant)
+			}
+		})
+	}
+}
+
+func TestResolveWithoutOperatorCredentials(t *testing.T) {
+	r, err := NewRegistry([]config.TenantConfig{
+		{Name: "billing", APIKeys: []string{"billing-key"}},
+	}, config.AuthConfig{})
+	if err != nil {
+		t.Fatalf("NewRegistry: %v", err)
+	}
+
+	// An empty secret must not turn a missing token into operator access
+	if _, err := r.Resolve("", nil); !errors.Is(err, ErrUnknownCaller) {
+		t.Fatalf("Resolve() error = %v, want %v", err, ErrUnknownCaller)
+	}
+	if _, err := r.Resolve("", certFor("")); !errors.Is(err, ErrUnknownCaller) {
+		t.Fatalf("Resolve() error = %v, want %v", err, ErrUnknownCaller)
+	}
+}
+
+func TestNewRegistry(t *testing.T) {
+	tests := []struct {
+		name    string
+		tenants []config.TenantConfig
+		auth    config.AuthConfig
+		wantErr bool
+	}{
+		{
+			name:    "disjoint namespaces",
+			tenants: []config.TenantConfig{{Name: "billing", APIKeys: []string{"a"}}, {Name: "bill", APIKeys: []string{"b"}}},
+		},
+		{
+			name:    "nested namespaces",
+			tenants: []config.TenantConfig{{Name: "a", Namespace: "team.", APIKeys: []string{"a"}}, {Name: "b", Namespace: "team.billing.", APIKeys: []string{"b"}}},
+			wantErr: true,
+		},
+		{
+			name:    "namespace prefixed by another",
+			tenants: []config.TenantConfig{{Name: "a", Namespace: "billing", APIKeys: []string{"a"}}, {Name: "b", Namespace: "billing-eu.", APIKeys: []string{"b"}}},
+			wantErr: true,
+		},
+		{
+			name:    "same name",
+			tenants: []config.TenantConfig{{Name: "billing", Namespace: "x.", APIKeys: []string{"a"}}, {Name: "billing", Namespace: "y.", APIKeys: []string{"b"}}},
+			wantErr: true,
+		},
+		{
+			name:    "missing name",
+			tenants: []config.TenantConfig{{APIKeys: []string{"a"}}},
+			wantErr: true,
+		},
+		{
+			name:    "no credentials",
+			tenants: []config.TenantConfig{{Name: "billing"}},
+			wantErr: true,
+		},
+		{
+			name:    "shared API key",
+			tenants: []config.TenantConfig{{Name: "a", APIKeys: []string{"key"}}, {Name: "b", APIKeys: []string{"key"}}},
+			wantErr: true,
+		},
+		{
+			name:    "shared identity",
+			tenants: []config.TenantConfig{{Name: "a", Identities: []string{"svc"}}, {Name: "b", Identities: []string{"svc"}}},
+			wantErr: true,
+		},
+		{
+			name:    "API key equals auth secret",
+			tenants: []config.TenantConfig{{Name: "a", APIKeys: []string{"secret"}}},
+			auth:    config.AuthConfig{Secret: "secret"},
+			wantErr: true,
+		},
+		{
+			name:    "identity is an operator identity",
+			tenants: []config.TenantConfig{{Name: "a", Identities: []string{"admin"}}},
+			auth:    config.AuthConfig{OperatorIdentities: []string{"admin"}},
+			wantErr: true,
+		},
+		{
+			name:    "empty operator identity",
+			auth:    config.AuthConfig{OperatorIdentities: []string{""}},
+			wantErr: true,
+		},
+		{
+			name:    "negative quota",
+			tenants: []config.TenantConfig{{Name: "a", APIKeys: []string{"a"}, Quota: config.QuotaConfig{RequestsPerSecond: -1}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRegistry(tt.tenants, tt.auth)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewRegistry() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTenantNames(t *testing.T) {
+	billing := &Tenant{Name: "billing", Namespace: "billing."}
+	var operator *Tenant
+
+	tests := []struct {
+		name      string
+		tenant    *Tenant
+		local     string
+		qualified string
+	}{
+		{name: "tenant", tenant: billing, local: "orders", qualified: "billing.orders"},
+		{name: "tenant nested name", tenant: billing, local: "eu.orders", qualified: "billing.eu.orders"},
+		{name: "operator", tenant: operator, local: "orders", qualified: "orders"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tenant.Qualify(tt.local); got != tt.qualified {
+				t.Errorf("Qualify(%q) = %q, want %q", tt.local, got, tt.qualified)
+			}
+			if got := tt.tenant.Local(tt.qualified); got != tt.local {
+				t.Errorf("Local(%q) = %q, want %q", tt.qualified, got, tt.local)
+			}
+			if !tt.tenant.Owns(tt.qualified) {
+				t.Errorf("Owns(%q) = false, want true", tt.qualified)
+			}
+		})
+	}
+
+	if billing.Owns("search.orders") {
+		t.Error("Owns(search.orders) = true, want false")
+	}
+	got := billing.LocalNames([]string{"billing.orders", "search.orders", "billing.payments"})
+	want := []string{"orders", "payments"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("LocalNames() = %v, want %v", got, want)
+	}
+	if err := billing.Forbid("ACL management"); !errors.Is(err, ErrForbidden) {
+		t.Errorf("Forbid() error = %v, want %v", err, ErrForbidden)
+	}
+	if err := operator.Forbid("ACL management"); err != nil {
+		t.Errorf("Forbid() on operator error = %v, want nil", err)
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	tests := []struct {
+		name    string
+		quota   config.QuotaConfig
+		allowed int
+	}{
+		{name: "unlimited", quota: config.QuotaConfig{}, allowed: 100},
+		{name: "explicit burst", quota: config.QuotaConfig{RequestsPerSecond: 1, Burst: 5}, allowed: 5},
+		{name: "burst defaults to one second", quota: config.QuotaConfig{RequestsPerSecond: 3}, allowed: 3},
+		{name: "burst of at least one", quota: config.QuotaConfig{RequestsPerSecond: 0.5}, allowed: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newLimiter(tt.quota)
+			if l != nil {
+				// Freeze refills so that only the burst counts
+				l.rate = 0
+			}
+			for i := 0; i < tt.allowed; i++ {
+				if !l.allow() {
+					t.Fatalf("request %d rejected within burst", i+1)
+				}
+			}
+			if l != nil && l.allow() {
+				t.Fatalf("request %d allowed beyond burst", tt.allowed+1)
+			}
+		})
+	}
+}
+
+func TestLimiterRefill(t *testing.T) {
+	l := newLimiter(config.QuotaConfig{RequestsPerSecond: 10, Burst: 1})
+	if !l.allow() {
+		t.Fatal("first request rejected")
+	}
+	if l.allow() {
+		t.Fatal("second request allowed before refill")
+	}
+	l.last = l.last.Add(-100 * time.Millisecond)
+	if !l.allow() {
+		t.Fatal("request rejected after refill")
+	}
+}
+
+func TestAllowQuotaExceeded(t *testing.T) {
+	billing := &Tenant{Name: "billing", limiter: newLimiter(config.QuotaConfig{RequestsPerSecond: 1, Burst: 1})}
+	billing.limiter.rate = 0
+	if err := billing.Allow(); err != nil {
+		t.Fatalf("Allow() error = %v", err)
+	}
+	if err := billing.Allow(); !errors.Is(err, ErrQuotaExceeded) {
+		t.Fatalf("Allow() error = %v, want %v", err, ErrQuotaExceeded)
+	}
+}
@@ -27,12 +27,54 @@ type KafkaGatewayServiceClient interface {
 	HealthCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 	// Publish message to Kafka topic
 	PublishMessage(ctx context.Context, in *PublishMessageRequest, opts ...grpc.CallOption) (*PublishMessageResponse, error)
+	// Publish a batch of messages to a Kafka topic
+	PublishBatch(ctx context.Context, in *PublishBatchRequest, opts ...grpc.CallOption) (*PublishBatchResponse, error)
 	// List all Kafka topics
 	ListTopics(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTopicsResponse, error)
 	// Get topic partitions
 	GetTopicPartitions(ctx context.Context, in *GetTopicPartitionsRequest, opts ...grpc.CallOption) (*GetTopicPartitionsResponse, error)
+	// Describe the partitions, replicas and offsets of a topic
+	DescribeTopic(ctx context.Context, in *DescribeTopicRequest, opts ...grpc.CallOption) (*DescribeTopicResponse, error)
+	// Find the earliest offset at or after a timestamp on every partition
+	GetOffsetsForTimestamp(ctx context.Context, in *GetOffsetsForTimestampRequest, opts ...grpc.CallOption) (*GetOffsetsForTimestampResponse, error)
+	// Delete the records of a topic before offsets or a timestamp
+	TruncateTopic(ctx context.Context, in *TruncateTopicRequest, opts ...grpc.CallOption) (*TruncateTopicResponse, error)
+	// Increase the partition count of a topic
+	CreatePartitions(ctx context.Context, in *CreatePartitionsRequest, opts ...grpc.CallOption) (*CreatePartitionsResponse, error)
 	// Create a new Kafka topic
 	CreateTopic(ctx context.Context, in *CreateTopicRequest, opts ...grpc.CallOption) (*CreateTopicResponse, error)
+	// Fetch messages from a topic partition
+	FetchMessages(ctx context.Context, in *FetchMessagesRequest, opts ...grpc.CallOption) (*FetchMessagesResponse, error)
+	// Subscribe to topics as a member of a consumer group
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (KafkaGatewayService_SubscribeClient, error)
+	// Acknowledge a message received from a subscription and commit its offset
+	AckMessage(ctx context.Context, in *AckMessageRequest, opts ...grpc.CallOption) (*AckMessageResponse, error)
+	// Delete a Kafka topic
+	DeleteTopic(ctx context.Context, in *DeleteTopicRequest, opts ...grpc.CallOption) (*DeleteTopicResponse, error)
+	// Describe the configuration of a topic
+	DescribeTopicConfig(ctx context.Context, in *DescribeTopicConfigRequest, opts ...grpc.CallOption) (*DescribeTopicConfigResponse, error)
+	// Incrementally alter the configuration of a topic
+	AlterTopicConfig(ctx context.Context, in *AlterTopicConfigRequest, opts ...grpc.CallOption) (*AlterTopicConfigResponse, error)
+	// List consumer groups
+	ListConsumerGroups(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListConsumerGroupsResponse, error)
+	// Describe the members and assignments of a consumer group
+	DescribeConsumerGroup(ctx context.Context, in *DescribeConsumerGroupRequest, opts ...grpc.CallOption) (*DescribeConsumerGroupResponse, error)
+	// Get the committed offsets and lag of a consumer group
+	GetConsumerGroupOffsets(ctx context.Context, in *GetConsumerGroupOffsetsRequest, opts ...grpc.CallOption) (*GetConsumerGroupOffsetsResponse, error)
+	// Delete a consumer group
+	DeleteConsumerGroup(ctx context.Context, in *DeleteConsumerGroupRequest, opts ...grpc.CallOption) (*DeleteConsumerGroupResponse, error)
+	// Reset the committed offsets of a consumer group on a topic
+	ResetConsumerGroupOffsets(ctx context.Context, in *ResetConsumerGroupOffsetsRequest, opts ...grpc.CallOption) (*ResetConsumerGroupOffsetsResponse, error)
+	// List the ACLs matching a filter
+	ListACLs(ctx context.Context, in *ACLFilter, opts ...grpc.CallOption) (*ListACLsResponse, error)
+	// Create ACLs
+	CreateACLs(ctx context.Context, in *CreateACLsRequest, opts ...grpc.CallOption) (*CreateACLsResponse, error)
+	// Delete the ACLs matching a filter
+	DeleteACLs(ctx context.Context, in *ACLFilter, opts ...grpc.CallOption) (*DeleteACLsResponse, error)
+	// Describe the cluster ID, controller and brokers
+	DescribeCluster(ctx context.Context, in *DescribeClusterRequest, opts ...grpc.CallOption) (*DescribeClusterResponse, error)
+	// Describe broker log directories and per-partition disk usage
+	DescribeLogDirs(ctx context.Context, in *DescribeLogDirsRequest, opts ...grpc.CallOption) (*DescribeLogDirsResponse, error)
 }
 
 type kafkaGatewayServiceClient struct {
@@ -61,6 +103,15 @@ func (c *kafkaGatewayServiceClient) PublishMessage(ctx context.Context, in *Publ
 	return out, nil
 }
 
+func (c *kafkaGatewayServiceClient) PublishBatch(ctx context.Context, in *PublishBatchRequest, opts ...grpc.CallOption) (*PublishBatchResponse, error) {
+	out := new(PublishBatchResponse)
+	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/PublishBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kafkaGatewayServiceClient) ListTopics(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTopicsResponse, error) {
 	out := new(ListTopicsResponse)
 	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/ListTopics", in, out, opts...)
@@ -79,6 +130,42 @@ func (c *kafkaGatewayServiceClient) GetTopicPartitions(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *kafkaGatewayServiceClient) DescribeTopic(ctx context.Context, in *DescribeTopicRequest, opts ...grpc.CallOption) (*DescribeTopicResponse, error) {
+	out := new(DescribeTopicResponse)
+	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/DescribeTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaGatewayServiceClient) GetOffsetsForTimestamp(ctx context.Context, in *GetOffsetsForTimestampRequest, opts ...grpc.CallOption) (*GetOffsetsForTimestampResponse, error) {
+	out := new(GetOffsetsForTimestampResponse)
+	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/GetOffsetsForTimestamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaGatewayServiceClient) TruncateTopic(ctx context.Context, in *TruncateTopicRequest, opts ...grpc.CallOption) (*TruncateTopicResponse, error) {
+	out := new(TruncateTopicResponse)
+	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/TruncateTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaGatewayServiceClient) CreatePartitions(ctx context.Context, in *CreatePartitionsRequest, opts ...grpc.CallOption) (*CreatePartitionsResponse, error) {
+	out := new(CreatePartitionsResponse)
+	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/CreatePartitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kafkaGatewayServiceClient) CreateTopic(ctx context.Context, in *CreateTopicRequest, opts ...grpc.CallOption) (*CreateTopicResponse, error) {
 	out := new(CreateTopicResponse)
 	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/CreateTopic", in, out, opts...)
@@ -88,6 +175,173 @@ func (c *kafkaGatewayServiceClient) CreateTopic(ctx context.Context, in *CreateT
 	return out, nil
 }
 
+func (c *kafkaGatewayServiceClient) FetchMessages(ctx context.Context, in *FetchMessagesRequest, opts ...grpc.CallOption) (*FetchMessagesResponse, error) {
+	out := new(FetchMessagesResponse)
+	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/FetchMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaGatewayServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (KafkaGatewayService_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &KafkaGatewayService_ServiceDesc.Streams[0], "/kafka.gateway.v1.KafkaGatewayService/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &kafkaGatewayServiceSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type KafkaGatewayService_SubscribeClient interface {
+	Recv() (*SubscribeResponse, error)
+	grpc.ClientStream
+}
+
+type kafkaGatewayServiceSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *kafkaGatewayServiceSubscribeClient) Recv() (*SubscribeResponse, error) {
+	m := new(SubscribeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *kafkaGatewayServiceClient) AckMessage(ctx context.Context, in *AckMessageRequest, opts ...grpc.CallOption) (*AckMessageResponse, error) {
+	out := new(AckMessageResponse)
+	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/AckMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaGatewayServiceClient) DeleteTopic(ctx context.Context, in *DeleteTopicRequest, opts ...grpc.CallOption) (*DeleteTopicResponse, error) {
+	out := new(DeleteTopicResponse)
+	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/DeleteTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaGatewayServiceClient) DescribeTopicConfig(ctx context.Context, in *DescribeTopicConfigRequest, opts ...grpc.CallOption) (*DescribeTopicConfigResponse, error) {
+	out := new(DescribeTopicConfigResponse)
+	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/DescribeTopicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaGatewayServiceClient) AlterTopicConfig(ctx context.Context, in *AlterTopicConfigRequest, opts ...grpc.CallOption) (*AlterTopicConfigResponse, error) {
+	out := new(AlterTopicConfigResponse)
+	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/AlterTopicConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaGatewayServiceClient) ListConsumerGroups(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListConsumerGroupsResponse, error) {
+	out := new(ListConsumerGroupsResponse)
+	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/ListConsumerGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaGatewayServiceClient) DescribeConsumerGroup(ctx context.Context, in *DescribeConsumerGroupRequest, opts ...grpc.CallOption) (*DescribeConsumerGroupResponse, error) {
+	out := new(DescribeConsumerGroupResponse)
+	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/DescribeConsumerGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaGatewayServiceClient) GetConsumerGroupOffsets(ctx context.Context, in *GetConsumerGroupOffsetsRequest, opts ...grpc.CallOption) (*GetConsumerGroupOffsetsResponse, error) {
+	out := new(GetConsumerGroupOffsetsResponse)
+	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/GetConsumerGroupOffsets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaGatewayServiceClient) DeleteConsumerGroup(ctx context.Context, in *DeleteConsumerGroupRequest, opts ...grpc.CallOption) (*DeleteConsumerGroupResponse, error) {
+	out := new(DeleteConsumerGroupResponse)
+	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/DeleteConsumerGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaGatewayServiceClient) ResetConsumerGroupOffsets(ctx context.Context, in *ResetConsumerGroupOffsetsRequest, opts ...grpc.CallOption) (*ResetConsumerGroupOffsetsResponse, error) {
+	out := new(ResetConsumerGroupOffsetsResponse)
+	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/ResetConsumerGroupOffsets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaGatewayServiceClient) ListACLs(ctx context.Context, in *ACLFilter, opts ...grpc.CallOption) (*ListACLsResponse, error) {
+	out := new(ListACLsResponse)
+	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/ListACLs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaGatewayServiceClient) CreateACLs(ctx context.Context, in *CreateACLsRequest, opts ...grpc.CallOption) (*CreateACLsResponse, error) {
+	out := new(CreateACLsResponse)
+	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/CreateACLs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaGatewayServiceClient) DeleteACLs(ctx context.Context, in *ACLFilter, opts ...grpc.CallOption) (*DeleteACLsResponse, error) {
+	out := new(DeleteACLsResponse)
+	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/DeleteACLs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaGatewayServiceClient) DescribeCluster(ctx context.Context, in *DescribeClusterRequest, opts ...grpc.CallOption) (*DescribeClusterResponse, error) {
+	out := new(DescribeClusterResponse)
+	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/DescribeCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaGatewayServiceClient) DescribeLogDirs(ctx context.Context, in *DescribeLogDirsRequest, opts ...grpc.CallOption) (*DescribeLogDirsResponse, error) {
+	out := new(DescribeLogDirsResponse)
+	err := c.cc.Invoke(ctx, "/kafka.gateway.v1.KafkaGatewayService/DescribeLogDirs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KafkaGatewayServiceServer is the server API for KafkaGatewayService service.
 // All implementations must embed UnimplementedKafkaGatewayServiceServer
 // for forward compatibility
@@ -96,12 +350,54 @@ type KafkaGatewayServiceServer interface {
 	HealthCheck(context.Context, *emptypb.Empty) (*HealthCheckResponse, error)
 	// Publish message to Kafka topic
 	PublishMessage(context.Context, *PublishMessageRequest) (*PublishMessageResponse, error)
+	// Publish a batch of messages to a Kafka topic
+	PublishBatch(context.Context, *PublishBatchRequest) (*PublishBatchResponse, error)
 	// List all Kafka topics
 	ListTopics(context.Context, *emptypb.Empty) (*ListTopicsResponse, error)
 	// Get topic partitions
 	GetTopicPartitions(context.Context, *GetTopicPartitionsRequest) (*GetTopicPartitionsResponse, error)
+	// Describe the partitions, replicas and offsets of a topic
+	DescribeTopic(context.Context, *DescribeTopicRequest) (*DescribeTopicResponse, error)
+	// Find the earliest offset at or after a timestamp on every partition
+	GetOffsetsForTimestamp(context.Context, *GetOffsetsForTimestampRequest) (*GetOffsetsForTimestampResponse, error)
+	// Delete the records of a topic before offsets or a timestamp
+	TruncateTopic(context.Context, *TruncateTopicRequest) (*TruncateTopicResponse, error)
+	// Increase the partition count of a topic
+	CreatePartitions(context.Context, *CreatePartitionsRequest) (*CreatePartitionsResponse, error)
 	// Create a new Kafka topic
 	CreateTopic(context.Context, *CreateTopicRequest) (*CreateTopicResponse, error)
+	// Fetch messages from a topic partition
+	FetchMessages(context.Context, *FetchMessagesRequest) (*FetchMessagesResponse, error)
+	// Subscribe to topics as a member of a consumer group
+	Subscribe(*SubscribeRequest, KafkaGatewayService_SubscribeServer) error
+	// Acknowledge a message received from a subscription and commit its offset
+	AckMessage(context.Context, *AckMessageRequest) (*AckMessageResponse, error)
+	// Delete a Kafka topic
+	DeleteTopic(context.Context, *DeleteTopicRequest) (*DeleteTopicResponse, error)
+	// Describe the configuration of a topic
+	DescribeTopicConfig(context.Context, *DescribeTopicConfigRequest) (*DescribeTopicConfigResponse, error)
+	// Incrementally alter the configuration of a topic
+	AlterTopicConfig(context.Context, *AlterTopicConfigRequest) (*AlterTopicConfigResponse, error)
+	// List consumer groups
+	ListConsumerGroups(context.Context, *emptypb.Empty) (*ListConsumerGroupsResponse, error)
+	// Describe the members and assignments of a consumer group
+	DescribeConsumerGroup(context.Context, *DescribeConsumerGroupRequest) (*DescribeConsumerGroupResponse, error)
+	// Get the committed offsets and lag of a consumer group
+	GetConsumerGroupOffsets(context.Context, *GetConsumerGroupOffsetsRequest) (*GetConsumerGroupOffsetsResponse, error)
+	// Delete a consumer group
+	DeleteConsumerGroup(context.Context, *DeleteConsumerGroupRequest) (*DeleteConsumerGroupResponse, error)
+	// Reset the committed offsets of a consumer group on a topic
+	ResetConsumerGroupOffsets(context.Context, *ResetConsumerGroupOffsetsRequest) (*ResetConsumerGroupOffsetsResponse, error)
+	// List the ACLs matching a filter
+	ListACLs(context.Context, *ACLFilter) (*ListACLsResponse, error)
+	// Create ACLs
+	CreateACLs(context.Context, *CreateACLsRequest) (*CreateACLsResponse, error)
+	// Delete the ACLs matching a filter
+	DeleteACLs(context.Context, *ACLFilter) (*DeleteACLsResponse, error)
+	// Describe the cluster ID, controller and brokers
+	DescribeCluster(context.Context, *DescribeClusterRequest) (*DescribeClusterResponse, error)
+	// Describe broker log directories and per-partition disk usage
+	DescribeLogDirs(context.Context, *DescribeLogDirsRequest) (*DescribeLogDirsResponse, error)
 	mustEmbedUnimplementedKafkaGatewayServiceServer()
 }
 
@@ -115,15 +411,78 @@ func (UnimplementedKafkaGatewayServiceServer) HealthCheck(context.Context, *empt
 func (UnimplementedKafkaGatewayServiceServer) PublishMessage(context.Context, *PublishMessageRequest) (*PublishMessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublishMessage not implemented")
 }
+func (UnimplementedKafkaGatewayServiceServer) PublishBatch(context.Context, *PublishBatchRequest) (*PublishBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishBatch not implemented")
+}
 func (UnimplementedKafkaGatewayServiceServer) ListTopics(context.Context, *emptypb.Empty) (*ListTopicsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTopics not implemented")
 }
 func (UnimplementedKafkaGatewayServiceServer) GetTopicPartitions(context.Context, *GetTopicPartitionsRequest) (*GetTopicPartitionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopicPartitions not implemented")
 }
+func (UnimplementedKafkaGatewayServiceServer) DescribeTopic(context.Context, *DescribeTopicRequest) (*DescribeTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTopic not implemented")
+}
+func (UnimplementedKafkaGatewayServiceServer) GetOffsetsForTimestamp(context.Context, *GetOffsetsForTimestampRequest) (*GetOffsetsForTimestampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOffsetsForTimestamp not implemented")
+}
+func (UnimplementedKafkaGatewayServiceServer) TruncateTopic(context.Context, *TruncateTopicRequest) (*TruncateTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TruncateTopic not implemented")
+}
+func (UnimplementedKafkaGatewayServiceServer) CreatePartitions(context.Context, *CreatePartitionsRequest) (*CreatePartitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePartitions not implemented")
+}
 func (UnimplementedKafkaGatewayServiceServer) CreateTopic(context.Context, *CreateTopicRequest) (*CreateTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTopic not implemented")
 }
+func (UnimplementedKafkaGatewayServiceServer) FetchMessages(context.Context, *FetchMessagesRequest) (*FetchMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchMessages not implemented")
+}
+func (UnimplementedKafkaGatewayServiceServer) Subscribe(*SubscribeRequest, KafkaGatewayService_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedKafkaGatewayServiceServer) AckMessage(context.Context, *AckMessageRequest) (*AckMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckMessage not implemented")
+}
+func (UnimplementedKafkaGatewayServiceServer) DeleteTopic(context.Context, *DeleteTopicRequest) (*DeleteTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTopic not implemented")
+}
+func (UnimplementedKafkaGatewayServiceServer) DescribeTopicConfig(context.Context, *DescribeTopicConfigRequest) (*DescribeTopicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTopicConfig not implemented")
+}
+func (UnimplementedKafkaGatewayServiceServer) AlterTopicConfig(context.Context, *AlterTopicConfigRequest) (*AlterTopicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterTopicConfig not implemented")
+}
+func (UnimplementedKafkaGatewayServiceServer) ListConsumerGroups(context.Context, *emptypb.Empty) (*ListConsumerGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConsumerGroups not implemented")
+}
+func (UnimplementedKafkaGatewayServiceServer) DescribeConsumerGroup(context.Context, *DescribeConsumerGroupRequest) (*DescribeConsumerGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeConsumerGroup not implemented")
+}
+func (UnimplementedKafkaGatewayServiceServer) GetConsumerGroupOffsets(context.Context, *GetConsumerGroupOffsetsRequest) (*GetConsumerGroupOffsetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsumerGroupOffsets not implemented")
+}
+func (UnimplementedKafkaGatewayServiceServer) DeleteConsumerGroup(context.Context, *DeleteConsumerGroupRequest) (*DeleteConsumerGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConsumerGroup not implemented")
+}
+func (UnimplementedKafkaGatewayServiceServer) ResetConsumerGroupOffsets(context.Context, *ResetConsumerGroupOffsetsRequest) (*ResetConsumerGroupOffsetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetConsumerGroupOffsets not implemented")
+}
+func (UnimplementedKafkaGatewayServiceServer) ListACLs(context.Context, *ACLFilter) (*ListACLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListACLs not implemented")
+}
+func (UnimplementedKafkaGatewayServiceServer) CreateACLs(context.Context, *CreateACLsRequest) (*CreateACLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateACLs not implemented")
+}
+func (UnimplementedKafkaGatewayServiceServer) DeleteACLs(context.Context, *ACLFilter) (*DeleteACLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteACLs not implemented")
+}
+func (UnimplementedKafkaGatewayServiceServer) DescribeCluster(context.Context, *DescribeClusterRequest) (*DescribeClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeCluster not implemented")
+}
+func (UnimplementedKafkaGatewayServiceServer) DescribeLogDirs(context.Context, *DescribeLogDirsRequest) (*DescribeLogDirsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeLogDirs not implemented")
+}
 func (UnimplementedKafkaGatewayServiceServer) mustEmbedUnimplementedKafkaGatewayServiceServer() {}
 
 // UnsafeKafkaGatewayServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -173,6 +532,24 @@ func _KafkaGatewayService_PublishMessage_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KafkaGatewayService_PublishBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaGatewayServiceServer).PublishBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kafka.gateway.v1.KafkaGatewayService/PublishBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaGatewayServiceServer).PublishBatch(ctx, req.(*PublishBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KafkaGatewayService_ListTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -209,6 +586,78 @@ func _KafkaGatewayService_GetTopicPartitions_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KafkaGatewayService_DescribeTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaGatewayServiceServer).DescribeTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kafka.gateway.v1.KafkaGatewayService/DescribeTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaGatewayServiceServer).DescribeTopic(ctx, req.(*DescribeTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaGatewayService_GetOffsetsForTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOffsetsForTimestampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaGatewayServiceServer).GetOffsetsForTimestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kafka.gateway.v1.KafkaGatewayService/GetOffsetsForTimestamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaGatewayServiceServer).GetOffsetsForTimestamp(ctx, req.(*GetOffsetsForTimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaGatewayService_TruncateTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TruncateTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaGatewayServiceServer).TruncateTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kafka.gateway.v1.KafkaGatewayService/TruncateTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaGatewayServiceServer).TruncateTopic(ctx, req.(*TruncateTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaGatewayService_CreatePartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaGatewayServiceServer).CreatePartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kafka.gateway.v1.KafkaGatewayService/CreatePartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaGatewayServiceServer).CreatePartitions(ctx, req.(*CreatePartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KafkaGatewayService_CreateTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateTopicRequest)
 	if err := dec(in); err != nil {
@@ -227,6 +676,297 @@ func _KafkaGatewayService_CreateTopic_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KafkaGatewayService_FetchMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaGatewayServiceServer).FetchMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kafka.gateway.v1.KafkaGatewayService/FetchMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaGatewayServiceServer).FetchMessages(ctx, req.(*FetchMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaGatewayService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KafkaGatewayServiceServer).Subscribe(m, &kafkaGatewayServiceSubscribeServer{stream})
+}
+
+type KafkaGatewayService_SubscribeServer interface {
+	Send(*SubscribeResponse) error
+	grpc.ServerStream
+}
+
+type kafkaGatewayServiceSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *kafkaGatewayServiceSubscribeServer) Send(m *SubscribeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _KafkaGatewayService_AckMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaGatewayServiceServer).AckMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kafka.gateway.v1.KafkaGatewayService/AckMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaGatewayServiceServer).AckMessage(ctx, req.(*AckMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaGatewayService_DeleteTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaGatewayServiceServer).DeleteTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kafka.gateway.v1.KafkaGatewayService/DeleteTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaGatewayServiceServer).DeleteTopic(ctx, req.(*DeleteTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaGatewayService_DescribeTopicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTopicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaGatewayServiceServer).DescribeTopicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kafka.gateway.v1.KafkaGatewayService/DescribeTopicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaGatewayServiceServer).DescribeTopicConfig(ctx, req.(*DescribeTopicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaGatewayService_AlterTopicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterTopicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaGatewayServiceServer).AlterTopicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kafka.gateway.v1.KafkaGatewayService/AlterTopicConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaGatewayServiceServer).AlterTopicConfig(ctx, req.(*AlterTopicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaGatewayService_ListConsumerGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaGatewayServiceServer).ListConsumerGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kafka.gateway.v1.KafkaGatewayService/ListConsumerGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaGatewayServiceServer).ListConsumerGroups(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaGatewayService_DescribeConsumerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeConsumerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaGatewayServiceServer).DescribeConsumerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kafka.gateway.v1.KafkaGatewayService/DescribeConsumerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaGatewayServiceServer).DescribeConsumerGroup(ctx, req.(*DescribeConsumerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaGatewayService_GetConsumerGroupOffsets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsumerGroupOffsetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaGatewayServiceServer).GetConsumerGroupOffsets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kafka.gateway.v1.KafkaGatewayService/GetConsumerGroupOffsets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaGatewayServiceServer).GetConsumerGroupOffsets(ctx, req.(*GetConsumerGroupOffsetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaGatewayService_DeleteConsumerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConsumerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaGatewayServiceServer).DeleteConsumerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kafka.gateway.v1.KafkaGatewayService/DeleteConsumerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaGatewayServiceServer).DeleteConsumerGroup(ctx, req.(*DeleteConsumerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaGatewayService_ResetConsumerGroupOffsets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetConsumerGroupOffsetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaGatewayServiceServer).ResetConsumerGroupOffsets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kafka.gateway.v1.KafkaGatewayService/ResetConsumerGroupOffsets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaGatewayServiceServer).ResetConsumerGroupOffsets(ctx, req.(*ResetConsumerGroupOffsetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaGatewayService_ListACLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ACLFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaGatewayServiceServer).ListACLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kafka.gateway.v1.KafkaGatewayService/ListACLs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaGatewayServiceServer).ListACLs(ctx, req.(*ACLFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaGatewayService_CreateACLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateACLsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaGatewayServiceServer).CreateACLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kafka.gateway.v1.KafkaGatewayService/CreateACLs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaGatewayServiceServer).CreateACLs(ctx, req.(*CreateACLsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaGatewayService_DeleteACLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ACLFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaGatewayServiceServer).DeleteACLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kafka.gateway.v1.KafkaGatewayService/DeleteACLs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaGatewayServiceServer).DeleteACLs(ctx, req.(*ACLFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaGatewayService_DescribeCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaGatewayServiceServer).DescribeCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kafka.gateway.v1.KafkaGatewayService/DescribeCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaGatewayServiceServer).DescribeCluster(ctx, req.(*DescribeClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaGatewayService_DescribeLogDirs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeLogDirsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaGatewayServiceServer).DescribeLogDirs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kafka.gateway.v1.KafkaGatewayService/DescribeLogDirs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaGatewayServiceServer).DescribeLogDirs(ctx, req.(*DescribeLogDirsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // KafkaGatewayService_ServiceDesc is the grpc.ServiceDesc for KafkaGatewayService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -242,6 +982,10 @@ var KafkaGatewayService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PublishMessage",
 			Handler:    _KafkaGatewayService_PublishMessage_Handler,
 		},
+		{
+			MethodName: "PublishBatch",
+			Handler:    _KafkaGatewayService_PublishBatch_Handler,
+		},
 		{
 			MethodName: "ListTopics",
 			Handler:    _KafkaGatewayService_ListTopics_Handler,
@@ -250,11 +994,93 @@ var KafkaGatewayService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTopicPartitions",
 			Handler:    _KafkaGatewayService_GetTopicPartitions_Handler,
 		},
+		{
+			MethodName: "DescribeTopic",
+			Handler:    _KafkaGatewayService_DescribeTopic_Handler,
+		},
+		{
+			MethodName: "GetOffsetsForTimestamp",
+			Handler:    _KafkaGatewayService_GetOffsetsForTimestamp_Handler,
+		},
+		{
+			MethodName: "TruncateTopic",
+			Handler:    _KafkaGatewayService_TruncateTopic_Handler,
+		},
+		{
+			MethodName: "CreatePartitions",
+			Handler:    _KafkaGatewayService_CreatePartitions_Handler,
+		},
 		{
 			MethodName: "CreateTopic",
 			Handler:    _KafkaGatewayService_CreateTopic_Handler,
 		},
+		{
+			MethodName: "FetchMessages",
+			Handler:    _KafkaGatewayService_FetchMessages_Handler,
+		},
+		{
+			MethodName: "AckMessage",
+			Handler:    _KafkaGatewayService_AckMessage_Handler,
+		},
+		{
+			MethodName: "DeleteTopic",
+			Handler:    _KafkaGatewayService_DeleteTopic_Handler,
+		},
+		{
+			MethodName: "DescribeTopicConfig",
+			Handler:    _KafkaGatewayService_DescribeTopicConfig_Handler,
+		},
+		{
+			MethodName: "AlterTopicConfig",
+			Handler:    _KafkaGatewayService_AlterTopicConfig_Handler,
+		},
+		{
+			MethodName: "ListConsumerGroups",
+			Handler:    _KafkaGatewayService_ListConsumerGroups_Handler,
+		},
+		{
+			MethodName: "DescribeConsumerGroup",
+			Handler:    _KafkaGatewayService_DescribeConsumerGroup_Handler,
+		},
+		{
+			MethodName: "GetConsumerGroupOffsets",
+			Handler:    _KafkaGatewayService_GetConsumerGroupOffsets_Handler,
+		},
+		{
+			MethodName: "DeleteConsumerGroup",
+			Handler:    _KafkaGatewayService_DeleteConsumerGroup_Handler,
+		},
+		{
+			MethodName: "ResetConsumerGroupOffsets",
+			Handler:    _KafkaGatewayService_ResetConsumerGroupOffsets_Handler,
+		},
+		{
+			MethodName: "ListACLs",
+			Handler:    _KafkaGatewayService_ListACLs_Handler,
+		},
+		{
+			MethodName: "CreateACLs",
+			Handler:    _KafkaGatewayService_CreateACLs_Handler,
+		},
+		{
+			MethodName: "DeleteACLs",
+			Handler:    _KafkaGatewayService_DeleteACLs_Handler,
+		},
+		{
+			MethodName: "DescribeCluster",
+			Handler:    _KafkaGatewayService_DescribeCluster_Handler,
+		},
+		{
+			MethodName: "DescribeLogDirs",
+			Handler:    _KafkaGatewayService_DescribeLogDirs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _KafkaGatewayService_Subscribe_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "kafka_gateway.proto",
 }